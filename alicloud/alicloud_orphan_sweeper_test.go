@@ -0,0 +1,621 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// The orphan sweeper deletes the resources left by the CI, which are selected by their tags and creation time instead
+// of their name prefixes. It is configured by the following environment variables:
+//
+//	ALICLOUD_SWEEP_TAGS     the tags of the victims, like created_by=terraform-ci,env=test. Defaults to created_by=terraform-ci.
+//	ALICLOUD_SWEEP_MIN_AGE  the minimum age of the victims, like 6h. Defaults to 3h.
+//	ALICLOUD_SWEEP_DRY_RUN  only reports the victims without deleting them when it is true.
+//	ALICLOUD_SWEEP_REPORT   the file which the JSON report is written to.
+//
+// When ALICLOUD_SWEEP_ALL_RESOURCES is true, the tags are ignored and all the resources older than the minimum age are deleted.
+const (
+	orphanSweeperName        = "alicloud_orphan_resources"
+	orphanSweepDefaultTags   = "created_by=terraform-ci"
+	orphanSweepDefaultMinAge = 3 * time.Hour
+
+	orphanSweepActionDelete  = "Delete"
+	orphanSweepActionDeleted = "Deleted"
+	orphanSweepActionFailed  = "Failed"
+	orphanSweepActionSkip    = "Skip"
+)
+
+// orphanSweeper declares how to list, describe and delete a type of resources.
+type orphanSweeper struct {
+	ResourceType string
+	// DependsOn is the resource types whose orphans should be deleted before this type, like the vSwitches of a VPC.
+	DependsOn []string
+
+	List     func(client *connectivity.AliyunClient) ([]map[string]interface{}, error)
+	Describe func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error)
+	Delete   func(client *connectivity.AliyunClient, id string, object map[string]interface{}) error
+	// Skip returns the reason if the resource should never be deleted, like a default VPC.
+	Skip func(object map[string]interface{}) string
+
+	IdField           string
+	NameField         string
+	CreationTimeField string
+	// TagsPath is the json path of the tags in the listed object. Both Key/Value and TagKey/TagValue are supported.
+	TagsPath string
+}
+
+// orphanFilter selects the victims by their tags and creation time.
+type orphanFilter struct {
+	Tags   map[string]string
+	MinAge time.Duration
+	Now    time.Time
+}
+
+// orphanSweepRecord is an entry of the sweeping report.
+type orphanSweepRecord struct {
+	ResourceType string            `json:"resource_type"`
+	Id           string            `json:"id"`
+	Name         string            `json:"name,omitempty"`
+	CreationTime string            `json:"creation_time,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Action       string            `json:"action"`
+	Reason       string            `json:"reason,omitempty"`
+}
+
+var orphanSweepers = []orphanSweeper{
+	{
+		ResourceType: "alicloud_ecs_network_interface",
+		List: func(client *connectivity.AliyunClient) ([]map[string]interface{}, error) {
+			return listOrphanCandidates(client, "Ecs", "2014-05-26", "DescribeNetworkInterfaces", "$.NetworkInterfaceSets.NetworkInterfaceSet")
+		},
+		Describe: func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error) {
+			return (&EcsService{client}).DescribeEcsNetworkInterface(id)
+		},
+		Delete: deleteOrphanNetworkInterface,
+		Skip: func(object map[string]interface{}) string {
+			if fmt.Sprint(object["Type"]) == "Primary" {
+				return "the primary network interface is deleted with its instance"
+			}
+			return ""
+		},
+		IdField:           "NetworkInterfaceId",
+		NameField:         "NetworkInterfaceName",
+		CreationTimeField: "CreationTime",
+		TagsPath:          "$.Tags.Tag",
+	},
+	{
+		ResourceType: "alicloud_security_group",
+		DependsOn:    []string{"alicloud_ecs_network_interface"},
+		List: func(client *connectivity.AliyunClient) ([]map[string]interface{}, error) {
+			return listOrphanCandidates(client, "Ecs", "2014-05-26", "DescribeSecurityGroups", "$.SecurityGroups.SecurityGroup")
+		},
+		Describe: func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error) {
+			return (&EcsServiceV2{client}).DescribeEcsSecurityGroup(id)
+		},
+		Delete:            deleteOrphanWithResource("alicloud_security_group"),
+		IdField:           "SecurityGroupId",
+		NameField:         "SecurityGroupName",
+		CreationTimeField: "CreationTime",
+		TagsPath:          "$.Tags.Tag",
+	},
+	{
+		ResourceType: "alicloud_vswitch",
+		DependsOn:    []string{"alicloud_ecs_network_interface"},
+		List: func(client *connectivity.AliyunClient) ([]map[string]interface{}, error) {
+			return listOrphanCandidates(client, "Vpc", "2016-04-28", "DescribeVSwitches", "$.VSwitches.VSwitch")
+		},
+		Describe: func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error) {
+			return (&VpcServiceV2{client}).DescribeVpcVswitch(id)
+		},
+		Delete:            deleteOrphanWithResource("alicloud_vswitch"),
+		Skip:              skipDefaultOrphan,
+		IdField:           "VSwitchId",
+		NameField:         "VSwitchName",
+		CreationTimeField: "CreationTime",
+		TagsPath:          "$.Tags.Tag",
+	},
+	{
+		ResourceType: "alicloud_vpc",
+		DependsOn:    []string{"alicloud_vswitch", "alicloud_security_group"},
+		List: func(client *connectivity.AliyunClient) ([]map[string]interface{}, error) {
+			return listOrphanCandidates(client, "Vpc", "2016-04-28", "DescribeVpcs", "$.Vpcs.Vpc")
+		},
+		Describe: func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error) {
+			return (&VpcServiceV2{client}).DescribeVpcVpc(id)
+		},
+		Delete:            deleteOrphanWithResource("alicloud_vpc"),
+		Skip:              skipDefaultOrphan,
+		IdField:           "VpcId",
+		NameField:         "VpcName",
+		CreationTimeField: "CreationTime",
+		TagsPath:          "$.Tags.Tag",
+	},
+}
+
+func init() {
+	resource.AddTestSweepers(orphanSweeperName, &resource.Sweeper{
+		Name: orphanSweeperName,
+		F:    testSweepOrphanResources,
+	})
+}
+
+func testSweepOrphanResources(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return fmt.Errorf("error getting Alicloud client: %s", err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	filter, err := orphanFilterFromEnv()
+	if err != nil {
+		return WrapError(err)
+	}
+	dryRun := os.Getenv("ALICLOUD_SWEEP_DRY_RUN") == "true"
+	records, err := sweepOrphans(client, orphanSweepers, filter, dryRun)
+	for _, record := range records {
+		log.Printf("[INFO] %s %s %s (%s): %s", record.Action, record.ResourceType, record.Id, record.Name, record.Reason)
+	}
+	if path := os.Getenv("ALICLOUD_SWEEP_REPORT"); path != "" {
+		content, jsonErr := json.MarshalIndent(records, "", "  ")
+		if jsonErr != nil {
+			return WrapError(jsonErr)
+		}
+		if writeErr := os.WriteFile(path, content, 0644); writeErr != nil {
+			return WrapError(writeErr)
+		}
+	}
+	return err
+}
+
+func orphanFilterFromEnv() (orphanFilter, error) {
+	filter := orphanFilter{
+		Tags:   map[string]string{},
+		MinAge: orphanSweepDefaultMinAge,
+		Now:    time.Now(),
+	}
+	if v := strings.TrimSpace(os.Getenv("ALICLOUD_SWEEP_MIN_AGE")); v != "" {
+		minAge, err := time.ParseDuration(v)
+		if err != nil {
+			return filter, fmt.Errorf("invalid ALICLOUD_SWEEP_MIN_AGE %q: %v", v, err)
+		}
+		filter.MinAge = minAge
+	}
+	if sweepAll() {
+		return filter, nil
+	}
+	tags := strings.TrimSpace(os.Getenv("ALICLOUD_SWEEP_TAGS"))
+	if tags == "" {
+		tags = orphanSweepDefaultTags
+	}
+	for _, tag := range strings.Split(tags, ",") {
+		parts := strings.SplitN(strings.TrimSpace(tag), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return filter, fmt.Errorf("invalid ALICLOUD_SWEEP_TAGS %q: expected key=value pairs separated by commas", tags)
+		}
+		filter.Tags[parts[0]] = parts[1]
+	}
+	return filter, nil
+}
+
+// sweepOrphans deletes the orphans of the sweepers in the order of their dependencies and returns the report. When
+// dryRun is true, the victims are only reported with the action Delete.
+func sweepOrphans(client *connectivity.AliyunClient, sweepers []orphanSweeper, filter orphanFilter, dryRun bool) ([]orphanSweepRecord, error) {
+	ordered, err := sortOrphanSweepers(sweepers)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]orphanSweepRecord, 0)
+	var failed []string
+	for _, sweeper := range ordered {
+		objects, err := sweeper.List(client)
+		if err != nil {
+			log.Printf("[ERROR] Failed to list %s: %v", sweeper.ResourceType, err)
+			failed = append(failed, sweeper.ResourceType)
+			continue
+		}
+		for _, object := range objects {
+			record := sweeper.record(object)
+			if reason := sweeper.skipReason(object, record, filter); reason != "" {
+				record.Action = orphanSweepActionSkip
+				record.Reason = reason
+				records = append(records, record)
+				continue
+			}
+			if dryRun {
+				record.Action = orphanSweepActionDelete
+				records = append(records, record)
+				continue
+			}
+			record.Action, record.Reason = sweeper.sweep(client, record.Id)
+			if record.Action == orphanSweepActionFailed {
+				failed = append(failed, sweeper.ResourceType+" "+record.Id)
+			}
+			records = append(records, record)
+		}
+	}
+	if len(failed) > 0 {
+		return records, fmt.Errorf("failed to sweep %s", strings.Join(failed, ", "))
+	}
+	return records, nil
+}
+
+func (s orphanSweeper) record(object map[string]interface{}) orphanSweepRecord {
+	record := orphanSweepRecord{
+		ResourceType: s.ResourceType,
+		Id:           fmt.Sprint(object[s.IdField]),
+		Tags:         orphanTags(object, s.TagsPath),
+	}
+	if v, ok := object[s.NameField]; ok && v != nil {
+		record.Name = fmt.Sprint(v)
+	}
+	if v, ok := object[s.CreationTimeField]; ok && v != nil {
+		record.CreationTime = fmt.Sprint(v)
+	}
+	return record
+}
+
+func (s orphanSweeper) skipReason(object map[string]interface{}, record orphanSweepRecord, filter orphanFilter) string {
+	if s.Skip != nil {
+		if reason := s.Skip(object); reason != "" {
+			return reason
+		}
+	}
+	for key, value := range filter.Tags {
+		if v, ok := record.Tags[key]; !ok || v != value {
+			return fmt.Sprintf("the tag %s=%s is not matched", key, value)
+		}
+	}
+	created, err := parseOrphanCreationTime(record.CreationTime)
+	if err != nil {
+		return fmt.Sprintf("the creation time %q is unknown", record.CreationTime)
+	}
+	if age := filter.Now.Sub(created); age < filter.MinAge {
+		return fmt.Sprintf("the age %s is less than %s", age.Round(time.Minute), filter.MinAge)
+	}
+	return ""
+}
+
+// sweep describes the resource again before deleting it, so that the resources which have been deleted by their
+// dependents are not reported as failures.
+func (s orphanSweeper) sweep(client *connectivity.AliyunClient, id string) (string, string) {
+	object, err := s.Describe(client, id)
+	if err != nil {
+		if NotFoundError(err) {
+			return orphanSweepActionSkip, "the resource has been deleted"
+		}
+		return orphanSweepActionFailed, err.Error()
+	}
+	if err := s.Delete(client, id, object); err != nil {
+		return orphanSweepActionFailed, err.Error()
+	}
+	return orphanSweepActionDeleted, ""
+}
+
+// sortOrphanSweepers sorts the sweepers so that every sweeper runs after the ones it depends on. The sweepers without
+// dependencies between them keep their declared order.
+func sortOrphanSweepers(sweepers []orphanSweeper) ([]orphanSweeper, error) {
+	indexes := make(map[string]int, len(sweepers))
+	for i, sweeper := range sweepers {
+		if _, ok := indexes[sweeper.ResourceType]; ok {
+			return nil, fmt.Errorf("the sweeper of %s is declared twice", sweeper.ResourceType)
+		}
+		indexes[sweeper.ResourceType] = i
+	}
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make([]int, len(sweepers))
+	ordered := make([]orphanSweeper, 0, len(sweepers))
+	var visit func(i int, path []string) error
+	visit = func(i int, path []string) error {
+		switch states[i] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("the sweepers have a dependency cycle: %s", strings.Join(append(path, sweepers[i].ResourceType), " -> "))
+		}
+		states[i] = visiting
+		for _, dependency := range sweepers[i].DependsOn {
+			j, ok := indexes[dependency]
+			if !ok {
+				return fmt.Errorf("the sweeper of %s depends on %s which is not declared", sweepers[i].ResourceType, dependency)
+			}
+			if err := visit(j, append(path, sweepers[i].ResourceType)); err != nil {
+				return err
+			}
+		}
+		states[i] = visited
+		ordered = append(ordered, sweepers[i])
+		return nil
+	}
+	for i := range sweepers {
+		if err := visit(i, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// listOrphanCandidates lists all the resources of an RPC action which supports the PageNumber pagination.
+func listOrphanCandidates(client *connectivity.AliyunClient, product, version, action, path string) ([]map[string]interface{}, error) {
+	request := map[string]interface{}{
+		"RegionId":   client.RegionId,
+		"PageSize":   PageSizeLarge,
+		"PageNumber": 1,
+	}
+	objects := make([]map[string]interface{}, 0)
+	for {
+		response, err := client.RpcPost(product, version, action, nil, request, true)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, product, action, AlibabaCloudSdkGoERROR)
+		}
+		resp, err := jsonpath.Get(path, response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, action, path, response)
+		}
+		result, _ := resp.([]interface{})
+		for _, v := range result {
+			if item, ok := v.(map[string]interface{}); ok {
+				objects = append(objects, item)
+			}
+		}
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
+
+// deleteOrphanWithResource deletes the orphan with the Delete function of the resource, so the retries and the waiting
+// of the resource are reused.
+func deleteOrphanWithResource(resourceType string) func(client *connectivity.AliyunClient, id string, object map[string]interface{}) error {
+	return func(client *connectivity.AliyunClient, id string, object map[string]interface{}) error {
+		r, ok := testAccProvider.ResourcesMap[resourceType]
+		if !ok {
+			return fmt.Errorf("the resource %s is not supported by the provider", resourceType)
+		}
+		d := r.Data(nil)
+		d.SetId(id)
+		return r.Delete(d, client)
+	}
+}
+
+func deleteOrphanNetworkInterface(client *connectivity.AliyunClient, id string, object map[string]interface{}) error {
+	if instanceId := fmt.Sprint(object["InstanceId"]); object["InstanceId"] != nil && instanceId != "" {
+		action := "DetachNetworkInterface"
+		request := map[string]interface{}{
+			"RegionId":           client.RegionId,
+			"InstanceId":         instanceId,
+			"NetworkInterfaceId": id,
+		}
+		if _, err := client.RpcPost("Ecs", "2014-05-26", action, nil, request, true); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+		}
+		ecsService := EcsService{client}
+		stateConf := BuildStateConf([]string{}, []string{"Available"}, DefaultTimeout, 5*time.Second, ecsService.EcsNetworkInterfaceStateRefreshFunc(id, []string{}))
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, IdMsg, id)
+		}
+	}
+	return deleteOrphanWithResource("alicloud_ecs_network_interface")(client, id, object)
+}
+
+func skipDefaultOrphan(object map[string]interface{}) string {
+	if v, ok := object["IsDefault"].(bool); ok && v {
+		return "the default resource is never swept"
+	}
+	return ""
+}
+
+// orphanTags converts the tags of the object, like [{"Key": "k", "Value": "v"}] or [{"TagKey": "k", "TagValue": "v"}],
+// to a map.
+func orphanTags(object map[string]interface{}, path string) map[string]string {
+	tags := make(map[string]string)
+	if path == "" {
+		return tags
+	}
+	v, err := jsonpath.Get(path, object)
+	if err != nil {
+		return tags
+	}
+	items, _ := v.([]interface{})
+	for _, item := range items {
+		tag, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, value := tag["Key"], tag["Value"]
+		if key == nil {
+			key, value = tag["TagKey"], tag["TagValue"]
+		}
+		if key == nil {
+			continue
+		}
+		if value == nil {
+			value = ""
+		}
+		tags[fmt.Sprint(key)] = fmt.Sprint(value)
+	}
+	return tags
+}
+
+func parseOrphanCreationTime(value string) (time.Time, error) {
+	var err error
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z07:00", "2006-01-02 15:04:05"} {
+		var t time.Time
+		if t, err = time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+func testOrphanSweepers(deleted *[]string, objects map[string][]map[string]interface{}) []orphanSweeper {
+	sweeper := func(resourceType, idField string, dependsOn ...string) orphanSweeper {
+		return orphanSweeper{
+			ResourceType: resourceType,
+			DependsOn:    dependsOn,
+			List: func(client *connectivity.AliyunClient) ([]map[string]interface{}, error) {
+				return objects[resourceType], nil
+			},
+			Describe: func(client *connectivity.AliyunClient, id string) (map[string]interface{}, error) {
+				for _, object := range objects[resourceType] {
+					if object[idField] == id {
+						return object, nil
+					}
+				}
+				return nil, WrapErrorf(NotFoundErr(resourceType, id), NotFoundMsg, ProviderERROR)
+			},
+			Delete: func(client *connectivity.AliyunClient, id string, object map[string]interface{}) error {
+				if object["DeleteError"] != nil {
+					return fmt.Errorf("%v", object["DeleteError"])
+				}
+				*deleted = append(*deleted, id)
+				return nil
+			},
+			Skip:              skipDefaultOrphan,
+			IdField:           idField,
+			NameField:         "Name",
+			CreationTimeField: "CreationTime",
+			TagsPath:          "$.Tags.Tag",
+		}
+	}
+	// The sweepers are declared in the reverse order to verify the dependencies.
+	return []orphanSweeper{
+		sweeper("alicloud_vpc", "VpcId", "alicloud_vswitch"),
+		sweeper("alicloud_vswitch", "VSwitchId", "alicloud_ecs_network_interface"),
+		sweeper("alicloud_ecs_network_interface", "NetworkInterfaceId"),
+	}
+}
+
+func TestUnitCommonSweepOrphans(t *testing.T) {
+	ciTags := map[string]interface{}{
+		"Tag": []interface{}{
+			map[string]interface{}{"Key": "created_by", "Value": "terraform-ci"},
+		},
+	}
+	objects := map[string][]map[string]interface{}{
+		"alicloud_vpc": {
+			{"VpcId": "vpc-old", "Name": "tf-test", "CreationTime": "2026-10-18T00:00:00Z", "Tags": ciTags},
+			{"VpcId": "vpc-new", "Name": "tf-test", "CreationTime": "2026-10-18T11:00:00Z", "Tags": ciTags},
+			{"VpcId": "vpc-default", "CreationTime": "2026-01-01T00:00:00Z", "IsDefault": true, "Tags": ciTags},
+			{"VpcId": "vpc-untagged", "Name": "tf-testacc", "CreationTime": "2026-01-01T00:00:00Z"},
+		},
+		"alicloud_vswitch": {
+			{"VSwitchId": "vsw-old", "CreationTime": "2026-10-18T00:00Z", "Tags": ciTags},
+			{"VSwitchId": "vsw-failed", "CreationTime": "2026-10-18T00:00:00Z", "Tags": ciTags, "DeleteError": "DependencyViolation"},
+		},
+		"alicloud_ecs_network_interface": {
+			{"NetworkInterfaceId": "eni-old", "CreationTime": "2026-10-17T00:00:00Z", "Tags": map[string]interface{}{
+				"Tag": []interface{}{
+					map[string]interface{}{"TagKey": "created_by", "TagValue": "terraform-ci"},
+				},
+			}},
+			{"NetworkInterfaceId": "eni-unknown", "CreationTime": "yesterday", "Tags": ciTags},
+		},
+	}
+	filter := orphanFilter{
+		Tags:   map[string]string{"created_by": "terraform-ci"},
+		MinAge: 3 * time.Hour,
+		Now:    time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}
+
+	var deleted []string
+	records, err := sweepOrphans(nil, testOrphanSweepers(&deleted, objects), filter, true)
+	assert.Nil(t, err)
+	assert.Empty(t, deleted)
+	actions := make(map[string]string)
+	for _, record := range records {
+		actions[record.Id] = record.Action
+	}
+	assert.Equal(t, map[string]string{
+		"eni-old":      orphanSweepActionDelete,
+		"eni-unknown":  orphanSweepActionSkip,
+		"vsw-old":      orphanSweepActionDelete,
+		"vsw-failed":   orphanSweepActionDelete,
+		"vpc-old":      orphanSweepActionDelete,
+		"vpc-new":      orphanSweepActionSkip,
+		"vpc-default":  orphanSweepActionSkip,
+		"vpc-untagged": orphanSweepActionSkip,
+	}, actions)
+	assert.Equal(t, "the age 1h0m0s is less than 3h0m0s", records[5].Reason)
+	assert.Equal(t, "the tag created_by=terraform-ci is not matched", records[7].Reason)
+
+	records, err = sweepOrphans(nil, testOrphanSweepers(&deleted, objects), filter, false)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "alicloud_vswitch vsw-failed")
+	assert.Equal(t, []string{"eni-old", "vsw-old", "vpc-old"}, deleted)
+	assert.Equal(t, orphanSweepActionFailed, records[3].Action)
+	assert.Equal(t, "DependencyViolation", records[3].Reason)
+}
+
+func TestUnitCommonSortOrphanSweepers(t *testing.T) {
+	ordered, err := sortOrphanSweepers(orphanSweepers)
+	assert.Nil(t, err)
+	positions := make(map[string]int)
+	for i, sweeper := range ordered {
+		positions[sweeper.ResourceType] = i
+	}
+	assert.Less(t, positions["alicloud_ecs_network_interface"], positions["alicloud_vswitch"])
+	assert.Less(t, positions["alicloud_vswitch"], positions["alicloud_vpc"])
+	assert.Less(t, positions["alicloud_security_group"], positions["alicloud_vpc"])
+
+	_, err = sortOrphanSweepers([]orphanSweeper{
+		{ResourceType: "alicloud_vpc", DependsOn: []string{"alicloud_vswitch"}},
+		{ResourceType: "alicloud_vswitch", DependsOn: []string{"alicloud_vpc"}},
+	})
+	assert.EqualError(t, err, "the sweepers have a dependency cycle: alicloud_vpc -> alicloud_vswitch -> alicloud_vpc")
+	_, err = sortOrphanSweepers([]orphanSweeper{
+		{ResourceType: "alicloud_vpc", DependsOn: []string{"alicloud_nat_gateway"}},
+	})
+	assert.EqualError(t, err, "the sweeper of alicloud_vpc depends on alicloud_nat_gateway which is not declared")
+}
+
+func TestUnitCommonOrphanFilterFromEnv(t *testing.T) {
+	t.Setenv("ALICLOUD_SWEEP_ALL_RESOURCES", "")
+	t.Setenv("ALICLOUD_SWEEP_TAGS", "")
+	t.Setenv("ALICLOUD_SWEEP_MIN_AGE", "")
+	filter, err := orphanFilterFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"created_by": "terraform-ci"}, filter.Tags)
+	assert.Equal(t, 3*time.Hour, filter.MinAge)
+
+	t.Setenv("ALICLOUD_SWEEP_TAGS", "created_by=terraform-ci, env=test")
+	t.Setenv("ALICLOUD_SWEEP_MIN_AGE", "30m")
+	filter, err = orphanFilterFromEnv()
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"created_by": "terraform-ci", "env": "test"}, filter.Tags)
+	assert.Equal(t, 30*time.Minute, filter.MinAge)
+
+	t.Setenv("ALICLOUD_SWEEP_TAGS", "terraform-ci")
+	_, err = orphanFilterFromEnv()
+	assert.NotNil(t, err)
+
+	t.Setenv("ALICLOUD_SWEEP_ALL_RESOURCES", "true")
+	filter, err = orphanFilterFromEnv()
+	assert.Nil(t, err)
+	assert.Empty(t, filter.Tags)
+}
+
+func TestUnitCommonOrphanSweepersDeclared(t *testing.T) {
+	for _, sweeper := range orphanSweepers {
+		_, ok := testAccProvider.ResourcesMap[sweeper.ResourceType]
+		assert.True(t, ok, sweeper.ResourceType)
+		assert.NotNil(t, sweeper.List, sweeper.ResourceType)
+		assert.NotNil(t, sweeper.Describe, sweeper.ResourceType)
+		assert.NotNil(t, sweeper.Delete, sweeper.ResourceType)
+	}
+}
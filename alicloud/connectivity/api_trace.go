@@ -0,0 +1,224 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	ossv2 "github.com/aliyun/alibabacloud-oss-go-sdk-v2/oss"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+)
+
+// ApiTraceRecord is one line of the api_trace_file, which records one API call.
+type ApiTraceRecord struct {
+	Time         string `json:"time"`
+	Product      string `json:"product"`
+	Action       string `json:"action,omitempty"`
+	Region       string `json:"region,omitempty"`
+	Endpoint     string `json:"endpoint,omitempty"`
+	HttpStatus   int    `json:"http_status,omitempty"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	RequestId    string `json:"request_id,omitempty"`
+	RetryCount   int    `json:"retry_count"`
+	DurationMs   int64  `json:"duration_ms"`
+}
+
+// apiTracer appends the API trace records to a file as JSON lines.
+type apiTracer struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+// apiTracers shares the tracer of one file among the provider configurations, like the ones with alias.
+var apiTracers sync.Map
+
+func getApiTracer(path string) (*apiTracer, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if v, ok := apiTracers.Load(path); ok {
+		return v.(*apiTracer), nil
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the api_trace_file %s got an error: %#v", path, err)
+	}
+	tracer, loaded := apiTracers.LoadOrStore(path, &apiTracer{file: file})
+	if loaded {
+		file.Close()
+	}
+	return tracer.(*apiTracer), nil
+}
+
+func (tracer *apiTracer) write(record ApiTraceRecord) {
+	if tracer == nil {
+		return
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		log.Printf("[WARN] marshaling the api trace record got an error: %#v", err)
+		return
+	}
+	tracer.mutex.Lock()
+	defer tracer.mutex.Unlock()
+	if _, err := tracer.file.Write(append(line, '\n')); err != nil {
+		log.Printf("[WARN] writing the api trace record got an error: %#v", err)
+	}
+}
+
+// traceApi writes a trace record of the API call if the provider api_trace_file is set.
+func (client *AliyunClient) traceApi(productCode, action, endpoint string, start time.Time, attempts int, response interface{}, err error) {
+	if client.apiTracer == nil {
+		return
+	}
+	record := ApiTraceRecord{
+		Time:       start.UTC().Format(time.RFC3339Nano),
+		Product:    normalizeProductCode(productCode),
+		Action:     action,
+		Region:     client.RegionId,
+		Endpoint:   endpoint,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+	if attempts > 1 {
+		record.RetryCount = attempts - 1
+	}
+	if err != nil {
+		record.HttpStatus, record.ErrorCode, record.RequestId, record.ErrorMessage = parseApiError(err)
+		record.ErrorMessage = redactSecrets(record.ErrorMessage)
+	} else {
+		record.HttpStatus, record.RequestId = parseApiResponse(response)
+		if record.Action == "" {
+			record.Action = parseApiAction(response)
+		}
+	}
+	client.apiTracer.write(record)
+}
+
+// parseApiError returns the HTTP status, error code, request id and message of the errors returned by the SDKs.
+func parseApiError(err error) (int, string, string, string) {
+	for err != nil {
+		switch e := err.(type) {
+		case *tea.SDKError:
+			requestId := ""
+			if data := tea.StringValue(e.Data); data != "" {
+				var body map[string]interface{}
+				if json.Unmarshal([]byte(data), &body) == nil {
+					requestId = parseRequestId(body)
+				}
+			}
+			return tea.IntValue(e.StatusCode), tea.StringValue(e.Code), requestId, tea.StringValue(e.Message)
+		case *errors.ServerError:
+			return e.HttpStatus(), e.ErrorCode(), e.RequestId(), e.Message()
+		case oss.ServiceError:
+			return e.StatusCode, e.Code, e.RequestID, e.Message
+		case *oss.ServiceError:
+			return e.StatusCode, e.Code, e.RequestID, e.Message
+		case *ossv2.ServiceError:
+			return e.StatusCode, e.Code, e.RequestID, e.Message
+		case *sls.Error:
+			return int(e.HTTPCode), e.Code, e.RequestID, e.Message
+		case *tablestore.OtsError:
+			return e.HttpStatusCode, e.Code, e.RequestId, e.Message
+		}
+		unwrapper, ok := err.(interface{ Unwrap() error })
+		if !ok {
+			break
+		}
+		if unwrapped := unwrapper.Unwrap(); unwrapped != err {
+			err = unwrapped
+			continue
+		}
+		break
+	}
+	if err == nil {
+		return 0, "", "", ""
+	}
+	return 0, "", "", err.Error()
+}
+
+// parseApiResponse returns the HTTP status and request id of the responses returned by the SDKs.
+func parseApiResponse(response interface{}) (int, string) {
+	switch v := response.(type) {
+	case nil:
+		return 0, ""
+	case map[string]interface{}:
+		return 200, parseRequestId(v)
+	}
+	status := 0
+	if r, ok := response.(interface{ GetHttpStatus() int }); ok {
+		status = r.GetHttpStatus()
+	}
+	value := reflect.ValueOf(response)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return status, ""
+		}
+		value = value.Elem()
+	}
+	if value.Kind() == reflect.Struct {
+		if field := value.FieldByName("RequestId"); field.IsValid() && field.Kind() == reflect.String {
+			return status, field.String()
+		}
+	}
+	return status, ""
+}
+
+// parseApiAction returns the action name from the response type of the SDKs, like DescribeInstances for
+// *ecs.DescribeInstancesResponse.
+func parseApiAction(response interface{}) string {
+	if response == nil {
+		return ""
+	}
+	typ := reflect.TypeOf(response)
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if name := typ.Name(); strings.HasSuffix(name, "Response") {
+		return strings.TrimSuffix(name, "Response")
+	}
+	return ""
+}
+
+func parseRequestId(body map[string]interface{}) string {
+	for _, key := range []string{"RequestId", "requestId", "request_id", "x-acs-request-id"} {
+		if v, ok := body[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	if headers, ok := body["headers"].(map[string]*string); ok {
+		return tea.StringValue(headers["x-acs-request-id"])
+	}
+	return ""
+}
+
+// withRequestId returns the response with the request id parsed from the response headers if its body has no request id.
+func withRequestId(response map[string]interface{}, requestId string) map[string]interface{} {
+	if requestId == "" || parseRequestId(response) != "" {
+		return response
+	}
+	return map[string]interface{}{"RequestId": requestId}
+}
+
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)((?:AccessKeyId|AccessKeySecret|SecurityToken|Signature|Password|Token|Secret)["']?\s*[=:]\s*["']?)([^"'&\s,}]+)`),
+	regexp.MustCompile(`(?i)(Authorization["']?\s*[=:]\s*["']?)([^"'&,}]+)`),
+}
+
+// redactSecrets masks the credentials, signatures and passwords in the message.
+func redactSecrets(message string) string {
+	for _, pattern := range secretPatterns {
+		message = pattern.ReplaceAllString(message, "${1}******")
+	}
+	return message
+}
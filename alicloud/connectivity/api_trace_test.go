@@ -0,0 +1,142 @@
+package connectivity
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/stretchr/testify/assert"
+)
+
+func readApiTraceRecords(t *testing.T, path string) []ApiTraceRecord {
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var records []ApiTraceRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record ApiTraceRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("the trace line %s is not a json: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestUnitCommonApiTraceRpcRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("Action") == "DeleteVpc" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"Code":"DependencyViolation","Message":"The specified VPC has dependent resources. SecurityToken=secret-token","RequestId":"F0A1B2C3-0000-0000-0000-000000000002"}`)
+			return
+		}
+		fmt.Fprint(w, `{"RequestId":"F0A1B2C3-0000-0000-0000-000000000001"}`)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	tracer, err := getApiTracer(path)
+	assert.Nil(t, err)
+	again, err := getApiTracer(path)
+	assert.Nil(t, err)
+	assert.True(t, tracer == again, "the tracer of one file should be shared")
+
+	client, fakeServer := newTeaPoolTestClient(t, &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"})
+	fakeServer.Close()
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+	client.config.Endpoints.Store("vpc", endpoint)
+	client.RegionId = "cn-hangzhou"
+	client.apiTracer = tracer
+
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", nil, nil, false)
+	assert.Nil(t, err)
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DeleteVpc", nil, nil, false)
+	assert.NotNil(t, err)
+
+	records := readApiTraceRecords(t, path)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "vpc", records[0].Product)
+	assert.Equal(t, "DescribeVpcs", records[0].Action)
+	assert.Equal(t, "cn-hangzhou", records[0].Region)
+	assert.Equal(t, endpoint, records[0].Endpoint)
+	assert.Equal(t, 200, records[0].HttpStatus)
+	assert.Equal(t, "F0A1B2C3-0000-0000-0000-000000000001", records[0].RequestId)
+	assert.Equal(t, 0, records[0].RetryCount)
+	assert.Empty(t, records[0].ErrorCode)
+
+	assert.Equal(t, "DeleteVpc", records[1].Action)
+	assert.Equal(t, 400, records[1].HttpStatus)
+	assert.Equal(t, "DependencyViolation", records[1].ErrorCode)
+	assert.Equal(t, "F0A1B2C3-0000-0000-0000-000000000002", records[1].RequestId)
+	assert.NotContains(t, records[1].ErrorMessage, "secret-token")
+}
+
+func TestUnitCommonParseApiError(t *testing.T) {
+	status, code, requestId, message := parseApiError(errors.NewServerError(404, `{"Code":"InvalidInstanceId.NotFound","Message":"The specified instance is not found.","RequestId":"ECS-REQUEST-ID"}`, ""))
+	assert.Equal(t, 404, status)
+	assert.Equal(t, "InvalidInstanceId.NotFound", code)
+	assert.Equal(t, "ECS-REQUEST-ID", requestId)
+	assert.Equal(t, "The specified instance is not found.", message)
+
+	status, code, requestId, _ = parseApiError(oss.ServiceError{Code: "NoSuchBucket", RequestID: "OSS-REQUEST-ID", StatusCode: 404})
+	assert.Equal(t, 404, status)
+	assert.Equal(t, "NoSuchBucket", code)
+	assert.Equal(t, "OSS-REQUEST-ID", requestId)
+
+	status, code, requestId, _ = parseApiError(&tea.SDKError{Code: tea.String("Throttling"), StatusCode: tea.Int(400), Message: tea.String(""), Data: tea.String(`{"RequestId":"TEA-REQUEST-ID"}`)})
+	assert.Equal(t, 400, status)
+	assert.Equal(t, "Throttling", code)
+	assert.Equal(t, "TEA-REQUEST-ID", requestId)
+
+	status, code, _, message = parseApiError(fmt.Errorf("wrapped: %w", &tea.SDKError{Code: tea.String("Forbidden"), StatusCode: tea.Int(403), Message: tea.String("")}))
+	assert.Equal(t, 403, status)
+	assert.Equal(t, "Forbidden", code)
+
+	_, code, _, message = parseApiError(fmt.Errorf("dial tcp: i/o timeout"))
+	assert.Empty(t, code)
+	assert.Equal(t, "dial tcp: i/o timeout", message)
+}
+
+func TestUnitCommonParseApiResponse(t *testing.T) {
+	response := ecs.CreateDescribeInstancesResponse()
+	response.RequestId = "ECS-REQUEST-ID"
+	_, requestId := parseApiResponse(response)
+	assert.Equal(t, "ECS-REQUEST-ID", requestId)
+	assert.Equal(t, "DescribeInstances", parseApiAction(response))
+
+	status, requestId := parseApiResponse(map[string]interface{}{"requestId": "ROA-REQUEST-ID"})
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "ROA-REQUEST-ID", requestId)
+
+	_, requestId = parseApiResponse(withRequestId(map[string]interface{}{"clusters": []interface{}{}}, "HEADER-REQUEST-ID"))
+	assert.Equal(t, "HEADER-REQUEST-ID", requestId)
+	assert.Equal(t, "", parseApiAction("ok"))
+}
+
+func TestUnitCommonRedactSecrets(t *testing.T) {
+	message := `Post "https://ecs.aliyuncs.com/?AccessKeyId=LTAI5tExample&Signature=abcd%3D&SecurityToken=CAIS.token&Action=DescribeInstances": dial tcp`
+	redacted := redactSecrets(message)
+	assert.NotContains(t, redacted, "LTAI5tExample")
+	assert.NotContains(t, redacted, "abcd%3D")
+	assert.NotContains(t, redacted, "CAIS.token")
+	assert.Contains(t, redacted, "Action=DescribeInstances")
+
+	redacted = redactSecrets(`{"Password": "Passw0rd!", "AccessKeySecret":"sk-secret", "InstanceName":"tf-test"}`)
+	assert.NotContains(t, redacted, "Passw0rd!")
+	assert.NotContains(t, redacted, "sk-secret")
+	assert.Contains(t, redacted, "tf-test")
+}
@@ -94,6 +94,11 @@ type AliyunClient struct {
 	teaRoaSdkConfig              roa.Config
 	teaRpcOpenapiConfig          openapi.Config
 	teaRoaOpenapiConfig          openapi.Config
+	teaClients                   *teaClientPool
+	teaClientPoolOnce            sync.Once
+	apiTracer                    *apiTracer
+	rateLimiter                  *rateLimiter
+	rateLimiterOnce              sync.Once
 	accountId                    string
 	ecsconn                      *ecs.Client
 	essconn                      *ess.Client
@@ -214,6 +219,10 @@ var EndpointMap = map[string]string{
 
 // Client for AliyunClient
 func (c *Config) Client() (*AliyunClient, error) {
+	// The recorder only sees the requests over HTTP, and it forwards them to the endpoints over HTTPS.
+	if getRecorderProxy() != nil {
+		c.Protocol = "HTTP"
+	}
 	// Get the auth and region. This can fail if keys/regions were not
 	// specified and we're attempting to use the environment.
 	if !c.SkipRegionValidation {
@@ -228,6 +237,10 @@ func (c *Config) Client() (*AliyunClient, error) {
 			return nil, err
 		}
 	}
+	apiTracer, err := getApiTracer(c.ApiTraceFile)
+	if err != nil {
+		return nil, err
+	}
 	teaSdkConfig, err := c.getTeaDslSdkConfig(true)
 	if err != nil {
 		return nil, err
@@ -250,6 +263,9 @@ func (c *Config) Client() (*AliyunClient, error) {
 		teaRoaSdkConfig:              teaRoaSdkConfig,
 		teaRpcOpenapiConfig:          teaRpcOpenapiConfig,
 		teaRoaOpenapiConfig:          teaRoaOpenapiConfig,
+		teaClients:                   newTeaClientPool(),
+		rateLimiter:                  newRateLimiter(c.ApiRateLimits),
+		apiTracer:                    apiTracer,
 		SourceIp:                     c.SourceIp,
 		Region:                       c.Region,
 		RegionId:                     c.RegionId,
@@ -271,9 +287,23 @@ func (c *Config) Client() (*AliyunClient, error) {
 	return client, nil
 }
 
+// invoke invokes the product request by the SDK client after it is allowed by the rate limiter,
+// adapts the product rate according to the request result and traces the request.
+func (client *AliyunClient) invoke(productCode string, do func() (interface{}, error)) (interface{}, error) {
+	limiter := client.getRateLimiter()
+	limiter.Wait(productCode)
+	start := time.Now()
+	raw, err := do()
+	client.traceApi(productCode, "", "", start, 1, raw, err)
+	limiter.Observe(productCode, err)
+	return raw, err
+}
+
 func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
 	if client.ecsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ecsconn)
+		return client.invoke("ecs", func() (interface{}, error) {
+			return do(client.ecsconn)
+		})
 	}
 	product := "ecs"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -313,12 +343,16 @@ func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, err
 	ecsconn.SourceIp = client.config.SourceIp
 	ecsconn.SecureTransport = client.config.SecureTransport
 	client.ecsconn = ecsconn
-	return do(client.ecsconn)
+	return client.invoke("ecs", func() (interface{}, error) {
+		return do(client.ecsconn)
+	})
 }
 
 func (client *AliyunClient) WithOfficalCSClient(do func(*officalCS.Client) (interface{}, error)) (interface{}, error) {
 	if client.officalCSConn != nil && !client.config.needRefreshCredential() {
-		return do(client.officalCSConn)
+		return client.invoke("cs", func() (interface{}, error) {
+			return do(client.officalCSConn)
+		})
 	}
 	product := "cs"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -354,12 +388,16 @@ func (client *AliyunClient) WithOfficalCSClient(do func(*officalCS.Client) (inte
 	csconn.SourceIp = client.config.SourceIp
 	csconn.SecureTransport = client.config.SecureTransport
 	client.officalCSConn = csconn
-	return do(client.officalCSConn)
+	return client.invoke("cs", func() (interface{}, error) {
+		return do(client.officalCSConn)
+	})
 }
 
 func (client *AliyunClient) WithPolarDBClient(do func(*polardb.Client) (interface{}, error)) (interface{}, error) {
 	if client.polarDBconn != nil && !client.config.needRefreshCredential() {
-		return do(client.polarDBconn)
+		return client.invoke("polardb", func() (interface{}, error) {
+			return do(client.polarDBconn)
+		})
 	}
 	product := "polardb"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -395,12 +433,16 @@ func (client *AliyunClient) WithPolarDBClient(do func(*polardb.Client) (interfac
 	polarDBconn.SourceIp = client.config.SourceIp
 	polarDBconn.SecureTransport = client.config.SecureTransport
 	client.polarDBconn = polarDBconn
-	return do(client.polarDBconn)
+	return client.invoke("polardb", func() (interface{}, error) {
+		return do(client.polarDBconn)
+	})
 }
 
 func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
 	if client.slbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.slbconn)
+		return client.invoke("slb", func() (interface{}, error) {
+			return do(client.slbconn)
+		})
 	}
 	product := "slb"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -436,12 +478,16 @@ func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, err
 	slbconn.SourceIp = client.config.SourceIp
 	slbconn.SecureTransport = client.config.SecureTransport
 	client.slbconn = slbconn
-	return do(client.slbconn)
+	return client.invoke("slb", func() (interface{}, error) {
+		return do(client.slbconn)
+	})
 }
 
 func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
 	if client.vpcconn != nil && !client.config.needRefreshCredential() {
-		return do(client.vpcconn)
+		return client.invoke("vpc", func() (interface{}, error) {
+			return do(client.vpcconn)
+		})
 	}
 	product := "vpc"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -477,12 +523,16 @@ func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, err
 	vpcconn.SourceIp = client.config.SourceIp
 	vpcconn.SecureTransport = client.config.SecureTransport
 	client.vpcconn = vpcconn
-	return do(client.vpcconn)
+	return client.invoke("vpc", func() (interface{}, error) {
+		return do(client.vpcconn)
+	})
 }
 
 func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
 	if client.essconn != nil && !client.config.needRefreshCredential() {
-		return do(client.essconn)
+		return client.invoke("ess", func() (interface{}, error) {
+			return do(client.essconn)
+		})
 	}
 	product := "ess"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -518,7 +568,9 @@ func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, err
 	essconn.SourceIp = client.config.SourceIp
 	essconn.SecureTransport = client.config.SecureTransport
 	client.essconn = essconn
-	return do(client.essconn)
+	return client.invoke("ess", func() (interface{}, error) {
+		return do(client.essconn)
+	})
 }
 
 func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
@@ -526,7 +578,9 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 	defer goSdkMutex.Unlock()
 
 	if client.ossconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ossconn)
+		return client.invoke("oss", func() (interface{}, error) {
+			return do(client.ossconn)
+		})
 	}
 	product := "oss"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -573,7 +627,9 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 	}
 
 	client.ossconn = ossconn
-	return do(client.ossconn)
+	return client.invoke("oss", func() (interface{}, error) {
+		return do(client.ossconn)
+	})
 }
 
 func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
@@ -591,7 +647,9 @@ func (client *AliyunClient) WithOssClientV2(do func(*ossv2.Client) (interface{},
 	defer goSdkMutex.Unlock()
 
 	if client.ossconnV2 != nil && !client.config.needRefreshCredential() {
-		return do(client.ossconnV2)
+		return client.invoke("oss", func() (interface{}, error) {
+			return do(client.ossconnV2)
+		})
 	}
 	product := "oss"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -625,12 +683,16 @@ func (client *AliyunClient) WithOssClientV2(do func(*ossv2.Client) (interface{},
 	}
 
 	client.ossconnV2 = ossv2.NewClient(cfg)
-	return do(client.ossconnV2)
+	return client.invoke("oss", func() (interface{}, error) {
+		return do(client.ossconnV2)
+	})
 }
 
 func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
 	if client.dnsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.dnsconn)
+		return client.invoke("alidns", func() (interface{}, error) {
+			return do(client.dnsconn)
+		})
 	}
 	product := "alidns"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -667,12 +729,16 @@ func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{},
 	dnsconn.SourceIp = client.config.SourceIp
 	dnsconn.SecureTransport = client.config.SecureTransport
 	client.dnsconn = dnsconn
-	return do(client.dnsconn)
+	return client.invoke("alidns", func() (interface{}, error) {
+		return do(client.dnsconn)
+	})
 }
 
 func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
 	if client.ramconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ramconn)
+		return client.invoke("ram", func() (interface{}, error) {
+			return do(client.ramconn)
+		})
 	}
 	product := "ram"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -709,12 +775,16 @@ func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, err
 	ramconn.SecureTransport = client.config.SecureTransport
 	client.ramconn = ramconn
 
-	return do(client.ramconn)
+	return client.invoke("ram", func() (interface{}, error) {
+		return do(client.ramconn)
+	})
 }
 
 func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
 	if client.csconn != nil && !client.config.needRefreshCredential() {
-		return do(client.csconn)
+		return client.invoke("cs", func() (interface{}, error) {
+			return do(client.csconn)
+		})
 	}
 	product := "cs"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -736,7 +806,9 @@ func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error
 	csconn.SetSourceIp(client.config.SourceIp)
 	csconn.SetSecureTransport(client.config.SecureTransport)
 	client.csconn = csconn
-	return do(client.csconn)
+	return client.invoke("cs", func() (interface{}, error) {
+		return do(client.csconn)
+	})
 }
 
 func (client *AliyunClient) NewRoaCsClient() (*roaCS.Client, error) {
@@ -778,7 +850,9 @@ func (client *AliyunClient) NewRoaCsClient() (*roaCS.Client, error) {
 
 func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
 	if client.crconn != nil && !client.config.needRefreshCredential() {
-		return do(client.crconn)
+		return client.invoke("cr", func() (interface{}, error) {
+			return do(client.crconn)
+		})
 	}
 	product := "cr"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -815,12 +889,16 @@ func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error
 	crconn.SecureTransport = client.config.SecureTransport
 	client.crconn = crconn
 
-	return do(client.crconn)
+	return client.invoke("cr", func() (interface{}, error) {
+		return do(client.crconn)
+	})
 }
 
 func (client *AliyunClient) WithCrEEClient(do func(*cr_ee.Client) (interface{}, error)) (interface{}, error) {
 	if client.creeconn != nil && !client.config.needRefreshCredential() {
-		return do(client.creeconn)
+		return client.invoke("cr", func() (interface{}, error) {
+			return do(client.creeconn)
+		})
 	}
 	product := "cr"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -857,7 +935,9 @@ func (client *AliyunClient) WithCrEEClient(do func(*cr_ee.Client) (interface{},
 	creeconn.SecureTransport = client.config.SecureTransport
 	client.creeconn = creeconn
 
-	return do(client.creeconn)
+	return client.invoke("cr", func() (interface{}, error) {
+		return do(client.creeconn)
+	})
 }
 
 func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{}, error)) (interface{}, error) {
@@ -879,12 +959,16 @@ func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{},
 		}
 		client.cdnconn = cdnconn
 	}
-	return do(client.cdnconn)
+	return client.invoke("cdn", func() (interface{}, error) {
+		return do(client.cdnconn)
+	})
 }
 
 func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
 	if client.cdnconn_new != nil && !client.config.needRefreshCredential() {
-		return do(client.cdnconn_new)
+		return client.invoke("cdn", func() (interface{}, error) {
+			return do(client.cdnconn_new)
+		})
 	}
 	product := "cdn"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -921,13 +1005,17 @@ func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interfac
 	cdnconn.SecureTransport = client.config.SecureTransport
 	client.cdnconn_new = cdnconn
 
-	return do(client.cdnconn_new)
+	return client.invoke("cdn", func() (interface{}, error) {
+		return do(client.cdnconn_new)
+	})
 }
 
 // WithOtsClient init ots openapi publish sdk client(if necessary), and exec do func by client
 func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
 	if client.otsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.otsconn)
+		return client.invoke("ots", func() (interface{}, error) {
+			return do(client.otsconn)
+		})
 	}
 	product := "ots"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -964,7 +1052,9 @@ func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, err
 	otsconn.SecureTransport = client.config.SecureTransport
 	client.otsconn = otsconn
 
-	return do(client.otsconn)
+	return client.invoke("ots", func() (interface{}, error) {
+		return do(client.otsconn)
+	})
 }
 
 // NewOtsRoaClient rpc client for common sdk
@@ -1001,7 +1091,9 @@ func (client *AliyunClient) NewOtsRoaClient(productCode string) (*roa.Client, er
 
 func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
 	if client.cmsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.cmsconn)
+		return client.invoke("cms", func() (interface{}, error) {
+			return do(client.cmsconn)
+		})
 	}
 	product := "cms"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1038,12 +1130,16 @@ func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, err
 	cmsconn.SecureTransport = client.config.SecureTransport
 	client.cmsconn = cmsconn
 
-	return do(client.cmsconn)
+	return client.invoke("cms", func() (interface{}, error) {
+		return do(client.cmsconn)
+	})
 }
 
 func (client *AliyunClient) WithLogPopClient(do func(*slsPop.Client) (interface{}, error)) (interface{}, error) {
 	if client.logpopconn != nil && !client.config.needRefreshCredential() {
-		return do(client.logpopconn)
+		return client.invoke("sls", func() (interface{}, error) {
+			return do(client.logpopconn)
+		})
 	}
 	product := "sls"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1080,7 +1176,9 @@ func (client *AliyunClient) WithLogPopClient(do func(*slsPop.Client) (interface{
 	logpopconn.Domain = endpoint + "/open-api"
 	client.logpopconn = logpopconn
 
-	return do(client.logpopconn)
+	return client.invoke("sls", func() (interface{}, error) {
+		return do(client.logpopconn)
+	})
 }
 
 func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
@@ -1088,7 +1186,9 @@ func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, err
 	defer goSdkMutex.Unlock()
 
 	if client.logconn != nil && !client.config.needRefreshCredential() {
-		return do(client.logconn)
+		return client.invoke("sls", func() (interface{}, error) {
+			return do(client.logconn)
+		})
 	}
 	product := "sls"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1124,12 +1224,16 @@ func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, err
 	}
 	applyLogClientSignVersion(client.logconn, client.config.SignVersion, "sls")
 
-	return do(client.logconn)
+	return client.invoke("sls", func() (interface{}, error) {
+		return do(client.logconn)
+	})
 }
 
 func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
 	if client.drdsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.drdsconn)
+		return client.invoke("drds", func() (interface{}, error) {
+			return do(client.drdsconn)
+		})
 	}
 	product := "drds"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1165,12 +1269,16 @@ func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, e
 	drdsconn.SourceIp = client.config.SourceIp
 	drdsconn.SecureTransport = client.config.SecureTransport
 	client.drdsconn = drdsconn
-	return do(client.drdsconn)
+	return client.invoke("drds", func() (interface{}, error) {
+		return do(client.drdsconn)
+	})
 }
 
 func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
 	if client.ddsconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ddsconn)
+		return client.invoke("dds", func() (interface{}, error) {
+			return do(client.ddsconn)
+		})
 	}
 	product := "dds"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1208,12 +1316,16 @@ func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, err
 	ddsconn.SecureTransport = client.config.SecureTransport
 	client.ddsconn = ddsconn
 
-	return do(client.ddsconn)
+	return client.invoke("dds", func() (interface{}, error) {
+		return do(client.ddsconn)
+	})
 }
 
 func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
 	if client.gpdbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.gpdbconn)
+		return client.invoke("gpdb", func() (interface{}, error) {
+			return do(client.gpdbconn)
+		})
 	}
 	product := "gpdb"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1250,14 +1362,18 @@ func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, e
 	gpdbconn.SourceIp = client.config.SourceIp
 	gpdbconn.SecureTransport = client.config.SecureTransport
 	client.gpdbconn = gpdbconn
-	return do(client.gpdbconn)
+	return client.invoke("gpdb", func() (interface{}, error) {
+		return do(client.gpdbconn)
+	})
 }
 
 func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 	if client.fcconn != nil && !client.config.needRefreshCredential() {
-		return do(client.fcconn)
+		return client.invoke("fc", func() (interface{}, error) {
+			return do(client.fcconn)
+		})
 	}
 	product := "fc"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1285,12 +1401,16 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 	fcconn.Config.SecurityToken = secretToken
 	client.fcconn = fcconn
 
-	return do(client.fcconn)
+	return client.invoke("fc", func() (interface{}, error) {
+		return do(client.fcconn)
+	})
 }
 
 func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
 	if client.cloudapiconn != nil && !client.config.needRefreshCredential() {
-		return do(client.cloudapiconn)
+		return client.invoke("cloudapi", func() (interface{}, error) {
+			return do(client.cloudapiconn)
+		})
 	}
 	product := "cloudapi"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1327,7 +1447,9 @@ func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interf
 	cloudapiconn.SecureTransport = client.config.SecureTransport
 	client.cloudapiconn = cloudapiconn
 
-	return do(client.cloudapiconn)
+	return client.invoke("cloudapi", func() (interface{}, error) {
+		return do(client.cloudapiconn)
+	})
 }
 
 func (client *AliyunClient) NewTeaCommonClient(endpoint string) (*rpc.Client, error) {
@@ -1398,12 +1520,16 @@ func (client *AliyunClient) WithDataHubClient(do func(api datahub.DataHubApi) (i
 		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
 	}
 
-	return do(client.dhconn)
+	return client.invoke("datahub", func() (interface{}, error) {
+		return do(client.dhconn)
+	})
 }
 
 func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
 	if client.elasticsearchconn != nil && !client.config.needRefreshCredential() {
-		return do(client.elasticsearchconn)
+		return client.invoke("elasticsearch", func() (interface{}, error) {
+			return do(client.elasticsearchconn)
+		})
 	}
 	product := "elasticsearch"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1440,7 +1566,9 @@ func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Clien
 	elasticsearchconn.SecureTransport = client.config.SecureTransport
 	client.elasticsearchconn = elasticsearchconn
 
-	return do(client.elasticsearchconn)
+	return client.invoke("elasticsearch", func() (interface{}, error) {
+		return do(client.elasticsearchconn)
+	})
 }
 
 func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface{}, error)) (interface{}, error) {
@@ -1480,7 +1608,9 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 		client.mnsconn = &mnsClient
 	}
 
-	return do(client.mnsconn)
+	return client.invoke("mns", func() (interface{}, error) {
+		return do(client.mnsconn)
+	})
 }
 func (client *AliyunClient) WithMnsQueueManager(do func(ali_mns.AliQueueManager) (interface{}, error)) (interface{}, error) {
 	return client.WithMnsClient(func(mnsClient *ali_mns.MNSClient) (interface{}, error) {
@@ -1510,14 +1640,16 @@ func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*t
 	// Initialize the TABLESTORE client if necessary
 	tableStoreClient, ok := client.tablestoreconnByInstanceName[instanceName]
 	if ok && !client.config.needRefreshCredential() {
-		return do(tableStoreClient)
+		return client.invoke("ots", func() (interface{}, error) {
+			return do(tableStoreClient)
+		})
 	}
 	endpoint := client.config.OtsEndpoint
 	if endpoint == "" {
 		endpoint = loadEndpoint(client.RegionId, OTSCode)
 	}
 	if endpoint == "" {
-		endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
+		endpoint = client.loadOtsInstanceEndpoint(instanceName)
 	}
 	if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
 		endpoint = fmt.Sprintf("https://%s", endpoint)
@@ -1533,7 +1665,9 @@ func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*t
 	tableStoreClient = tablestore.NewClientWithExternalHeader(endpoint, instanceName, accessKey, secretKey, token, tablestore.NewDefaultTableStoreConfig(), externalHeaders)
 	client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 
-	return do(tableStoreClient)
+	return client.invoke("ots", func() (interface{}, error) {
+		return do(tableStoreClient)
+	})
 }
 
 func (client *AliyunClient) WithTableStoreTunnelClient(instanceName string, do func(otsTunnel.TunnelClient) (interface{}, error)) (interface{}, error) {
@@ -1543,14 +1677,16 @@ func (client *AliyunClient) WithTableStoreTunnelClient(instanceName string, do f
 	// Initialize the TABLESTORE tunnel client if necessary
 	tunnelClient, ok := client.otsTunnelConnByInstanceName[instanceName]
 	if ok && !client.config.needRefreshCredential() {
-		return do(tunnelClient)
+		return client.invoke("ots", func() (interface{}, error) {
+			return do(tunnelClient)
+		})
 	}
 	endpoint := client.config.OtsEndpoint
 	if endpoint == "" {
 		endpoint = loadEndpoint(client.RegionId, OTSCode)
 	}
 	if endpoint == "" {
-		endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
+		endpoint = client.loadOtsInstanceEndpoint(instanceName)
 	}
 	if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
 		endpoint = fmt.Sprintf("https://%s", endpoint)
@@ -1567,7 +1703,9 @@ func (client *AliyunClient) WithTableStoreTunnelClient(instanceName string, do f
 	tunnelClient = otsTunnel.NewTunnelClientWithConfigAndExternalHeader(endpoint, instanceName, accessKey, secretKey, token, otsTunnel.DefaultTunnelConfig, externalHeaders)
 	client.otsTunnelConnByInstanceName[instanceName] = tunnelClient
 
-	return do(tunnelClient)
+	return client.invoke("ots", func() (interface{}, error) {
+		return do(tunnelClient)
+	})
 }
 
 func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clusterCerts cs.ClusterCerts, do func(*cs.ProjectClient) (interface{}, error)) (interface{}, error) {
@@ -1588,7 +1726,9 @@ func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clus
 		client.csprojectconnByKey[key] = csProjectClient
 	}
 
-	return do(csProjectClient)
+	return client.invoke("cs", func() (interface{}, error) {
+		return do(csProjectClient)
+	})
 }
 
 func (client *AliyunClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
@@ -1643,6 +1783,44 @@ func (client *AliyunClient) AccountId() (string, error) {
 	return client.accountId, nil
 }
 
+// ValidateAccountId checks the account of the caller identity against the provider allowed_account_ids and
+// forbidden_account_ids. The caller identity is always queried, because the account_id may be stale as the credential.
+func (client *AliyunClient) ValidateAccountId() error {
+	if len(client.config.AllowedAccountIds) == 0 && len(client.config.ForbiddenAccountIds) == 0 {
+		return nil
+	}
+	identity, err := client.GetCallerIdentity()
+	if err != nil {
+		return fmt.Errorf("getting the caller identity to validate the allowed_account_ids and forbidden_account_ids failed: %v", err)
+	}
+	if identity.AccountId == "" {
+		return fmt.Errorf("caller identity doesn't contain any AccountId")
+	}
+	if client.accountId == "" {
+		client.accountId = identity.AccountId
+	}
+	return validateAccountId(identity.AccountId, client.config.AllowedAccountIds, client.config.ForbiddenAccountIds)
+}
+
+func validateAccountId(accountId string, allowedAccountIds, forbiddenAccountIds []string) error {
+	for _, forbiddenAccountId := range forbiddenAccountIds {
+		if accountId == forbiddenAccountId {
+			return fmt.Errorf("the Alibaba Cloud account ID %s of the provider credential is in the forbidden_account_ids %v. "+
+				"Please check the credential and profile of the provider", accountId, forbiddenAccountIds)
+		}
+	}
+	if len(allowedAccountIds) == 0 {
+		return nil
+	}
+	for _, allowedAccountId := range allowedAccountIds {
+		if accountId == allowedAccountId {
+			return nil
+		}
+	}
+	return fmt.Errorf("the Alibaba Cloud account ID %s of the provider credential is not in the allowed_account_ids %v. "+
+		"Please check the credential and profile of the provider", accountId, allowedAccountIds)
+}
+
 // getAccountType determines and returns the account type (Domestic or International) based on the client's configuration and API endpoint.
 // This function first checks if the AccountType is already set in the client configuration. If so, it returns that value directly.
 // Otherwise, it defaults the account type to "Domestic" and initializes a request to query available instances through the BssOpenApi API.
@@ -1733,11 +1911,17 @@ func (client *AliyunClient) getTransport() *http.Transport {
 	}
 	transport := &http.Transport{}
 	transport.TLSHandshakeTimeout = time.Duration(handshakeTimeout) * time.Second
+	if proxy := getRecorderProxy(); proxy != nil {
+		transport.Proxy = http.ProxyURL(proxy)
+	}
 
 	return transport
 }
 
 func (client *AliyunClient) getHttpProxy() (proxy *url.URL, err error) {
+	if proxy = getRecorderProxy(); proxy != nil {
+		return proxy, nil
+	}
 	if client.config.Protocol == "HTTPS" {
 		if rawurl := os.Getenv("HTTPS_PROXY"); rawurl != "" {
 			proxy, err = url.Parse(rawurl)
@@ -1755,6 +1939,9 @@ func (client *AliyunClient) getHttpProxy() (proxy *url.URL, err error) {
 }
 
 func (client *AliyunClient) skipProxy(endpoint string) (bool, error) {
+	if getRecorderProxy() != nil {
+		return false, nil
+	}
 	var urls []string
 	if rawurl := os.Getenv("NO_PROXY"); rawurl != "" {
 		urls = strings.Split(rawurl, ",")
@@ -1807,7 +1994,9 @@ func (client *AliyunClient) GetCallerIdentity() (*sts.GetCallerIdentityResponse,
 }
 func (client *AliyunClient) WithDdosbgpClient(do func(*ddosbgp.Client) (interface{}, error)) (interface{}, error) {
 	if client.ddosbgpconn != nil && !client.config.needRefreshCredential() {
-		return do(client.ddosbgpconn)
+		return client.invoke("ddosbgp", func() (interface{}, error) {
+			return do(client.ddosbgpconn)
+		})
 	}
 	product := "ddosbgp"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1844,11 +2033,15 @@ func (client *AliyunClient) WithDdosbgpClient(do func(*ddosbgp.Client) (interfac
 	ddosbgpconn.SecureTransport = client.config.SecureTransport
 	client.ddosbgpconn = ddosbgpconn
 
-	return do(client.ddosbgpconn)
+	return client.invoke("ddosbgp", func() (interface{}, error) {
+		return do(client.ddosbgpconn)
+	})
 }
 func (client *AliyunClient) WithAlikafkaClient(do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
 	if client.alikafkaconn != nil && !client.config.needRefreshCredential() {
-		return do(client.alikafkaconn)
+		return client.invoke("alikafka", func() (interface{}, error) {
+			return do(client.alikafkaconn)
+		})
 	}
 	product := "alikafka"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1885,12 +2078,16 @@ func (client *AliyunClient) WithAlikafkaClient(do func(*alikafka.Client) (interf
 	alikafkaconn.SecureTransport = client.config.SecureTransport
 	client.alikafkaconn = alikafkaconn
 
-	return do(client.alikafkaconn)
+	return client.invoke("alikafka", func() (interface{}, error) {
+		return do(client.alikafkaconn)
+	})
 }
 
 func (client *AliyunClient) WithEmrClient(do func(*emr.Client) (interface{}, error)) (interface{}, error) {
 	if client.emrconn != nil && !client.config.needRefreshCredential() {
-		return do(client.emrconn)
+		return client.invoke("emr", func() (interface{}, error) {
+			return do(client.emrconn)
+		})
 	}
 	product := "emr"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1927,12 +2124,16 @@ func (client *AliyunClient) WithEmrClient(do func(*emr.Client) (interface{}, err
 	emrConn.SecureTransport = client.config.SecureTransport
 	client.emrconn = emrConn
 
-	return do(client.emrconn)
+	return client.invoke("emr", func() (interface{}, error) {
+		return do(client.emrconn)
+	})
 }
 
 func (client *AliyunClient) WithSagClient(do func(*smartag.Client) (interface{}, error)) (interface{}, error) {
 	if client.sagconn != nil && !client.config.needRefreshCredential() {
-		return do(client.sagconn)
+		return client.invoke("smartag", func() (interface{}, error) {
+			return do(client.sagconn)
+		})
 	}
 	product := "smartag"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -1969,12 +2170,16 @@ func (client *AliyunClient) WithSagClient(do func(*smartag.Client) (interface{},
 	sagconn.SecureTransport = client.config.SecureTransport
 	client.sagconn = sagconn
 
-	return do(client.sagconn)
+	return client.invoke("smartag", func() (interface{}, error) {
+		return do(client.sagconn)
+	})
 }
 
 func (client *AliyunClient) WithDbauditClient(do func(*yundun_dbaudit.Client) (interface{}, error)) (interface{}, error) {
 	if client.dbauditconn != nil && !client.config.needRefreshCredential() {
-		return do(client.dbauditconn)
+		return client.invoke("yundun_dbaudit", func() (interface{}, error) {
+			return do(client.dbauditconn)
+		})
 	}
 	product := "yundun_dbaudit"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2011,11 +2216,15 @@ func (client *AliyunClient) WithDbauditClient(do func(*yundun_dbaudit.Client) (i
 	dbauditconn.SecureTransport = client.config.SecureTransport
 	client.dbauditconn = dbauditconn
 
-	return do(client.dbauditconn)
+	return client.invoke("yundun_dbaudit", func() (interface{}, error) {
+		return do(client.dbauditconn)
+	})
 }
 func (client *AliyunClient) WithMarketClient(do func(*market.Client) (interface{}, error)) (interface{}, error) {
 	if client.marketconn != nil && !client.config.needRefreshCredential() {
-		return do(client.marketconn)
+		return client.invoke("market", func() (interface{}, error) {
+			return do(client.marketconn)
+		})
 	}
 	product := "market"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2052,12 +2261,16 @@ func (client *AliyunClient) WithMarketClient(do func(*market.Client) (interface{
 	marketconn.SecureTransport = client.config.SecureTransport
 	client.marketconn = marketconn
 
-	return do(client.marketconn)
+	return client.invoke("market", func() (interface{}, error) {
+		return do(client.marketconn)
+	})
 }
 
 func (client *AliyunClient) WithHbaseClient(do func(*hbase.Client) (interface{}, error)) (interface{}, error) {
 	if client.hbaseconn != nil && !client.config.needRefreshCredential() {
-		return do(client.hbaseconn)
+		return client.invoke("hbase", func() (interface{}, error) {
+			return do(client.hbaseconn)
+		})
 	}
 	product := "hbase"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2095,12 +2308,16 @@ func (client *AliyunClient) WithHbaseClient(do func(*hbase.Client) (interface{},
 
 	client.hbaseconn = hbaseconn
 
-	return do(client.hbaseconn)
+	return client.invoke("hbase", func() (interface{}, error) {
+		return do(client.hbaseconn)
+	})
 }
 
 func (client *AliyunClient) WithAdbClient(do func(*adb.Client) (interface{}, error)) (interface{}, error) {
 	if client.adbconn != nil && !client.config.needRefreshCredential() {
-		return do(client.adbconn)
+		return client.invoke("adb", func() (interface{}, error) {
+			return do(client.adbconn)
+		})
 	}
 	product := "adb"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2138,11 +2355,15 @@ func (client *AliyunClient) WithAdbClient(do func(*adb.Client) (interface{}, err
 	adbconn.SecureTransport = client.config.SecureTransport
 	client.adbconn = adbconn
 
-	return do(client.adbconn)
+	return client.invoke("adb", func() (interface{}, error) {
+		return do(client.adbconn)
+	})
 }
 func (client *AliyunClient) WithCbnClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
 	if client.cbnConn != nil && !client.config.needRefreshCredential() {
-		return do(client.cbnConn)
+		return client.invoke("cbn", func() (interface{}, error) {
+			return do(client.cbnConn)
+		})
 	}
 	product := "cbn"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2179,12 +2400,16 @@ func (client *AliyunClient) WithCbnClient(do func(*cbn.Client) (interface{}, err
 	cbnConn.SecureTransport = client.config.SecureTransport
 	client.cbnConn = cbnConn
 
-	return do(client.cbnConn)
+	return client.invoke("cbn", func() (interface{}, error) {
+		return do(client.cbnConn)
+	})
 }
 
 func (client *AliyunClient) WithEdasClient(do func(*edas.Client) (interface{}, error)) (interface{}, error) {
 	if client.edasconn != nil && !client.config.needRefreshCredential() {
-		return do(client.edasconn)
+		return client.invoke("edas", func() (interface{}, error) {
+			return do(client.edasconn)
+		})
 	}
 	product := "edas"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2221,12 +2446,16 @@ func (client *AliyunClient) WithEdasClient(do func(*edas.Client) (interface{}, e
 	edasconn.SecureTransport = client.config.SecureTransport
 	client.edasconn = edasconn
 
-	return do(client.edasconn)
+	return client.invoke("edas", func() (interface{}, error) {
+		return do(client.edasconn)
+	})
 }
 
 func (client *AliyunClient) WithAlidnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
 	if client.alidnsConn != nil && !client.config.needRefreshCredential() {
-		return do(client.alidnsConn)
+		return client.invoke("alidns", func() (interface{}, error) {
+			return do(client.alidnsConn)
+		})
 	}
 	product := "alidns"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2264,12 +2493,16 @@ func (client *AliyunClient) WithAlidnsClient(do func(*alidns.Client) (interface{
 	alidnsConn.SourceIp = client.config.SourceIp
 	alidnsConn.SecureTransport = client.config.SecureTransport
 	client.alidnsConn = alidnsConn
-	return do(client.alidnsConn)
+	return client.invoke("alidns", func() (interface{}, error) {
+		return do(client.alidnsConn)
+	})
 }
 
 func (client *AliyunClient) WithCassandraClient(do func(*cassandra.Client) (interface{}, error)) (interface{}, error) {
 	if client.cassandraConn != nil && !client.config.needRefreshCredential() {
-		return do(client.cassandraConn)
+		return client.invoke("cassandra", func() (interface{}, error) {
+			return do(client.cassandraConn)
+		})
 	}
 	product := "cassandra"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2305,12 +2538,16 @@ func (client *AliyunClient) WithCassandraClient(do func(*cassandra.Client) (inte
 	cassandraConn.SourceIp = client.config.SourceIp
 	cassandraConn.SecureTransport = client.config.SecureTransport
 	client.cassandraConn = cassandraConn
-	return do(client.cassandraConn)
+	return client.invoke("cassandra", func() (interface{}, error) {
+		return do(client.cassandraConn)
+	})
 }
 
 func (client *AliyunClient) WithEciClient(do func(*eci.Client) (interface{}, error)) (interface{}, error) {
 	if client.eciConn != nil && !client.config.needRefreshCredential() {
-		return do(client.eciConn)
+		return client.invoke("eci", func() (interface{}, error) {
+			return do(client.eciConn)
+		})
 	}
 	product := "eci"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2348,11 +2585,15 @@ func (client *AliyunClient) WithEciClient(do func(*eci.Client) (interface{}, err
 	eciConn.SourceIp = client.config.SourceIp
 	eciConn.SecureTransport = client.config.SecureTransport
 	client.eciConn = eciConn
-	return do(client.eciConn)
+	return client.invoke("eci", func() (interface{}, error) {
+		return do(client.eciConn)
+	})
 }
 func (client *AliyunClient) WithRKvstoreClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
 	if client.r_kvstoreConn != nil && !client.config.needRefreshCredential() {
-		return do(client.r_kvstoreConn)
+		return client.invoke("r_kvstore", func() (interface{}, error) {
+			return do(client.r_kvstoreConn)
+		})
 	}
 	product := "r_kvstore"
 	endpoint, err := client.loadApiEndpoint(product)
@@ -2389,7 +2630,9 @@ func (client *AliyunClient) WithRKvstoreClient(do func(*r_kvstore.Client) (inter
 	r_kvstoreConn.SecureTransport = client.config.SecureTransport
 	client.r_kvstoreConn = r_kvstoreConn
 
-	return do(client.r_kvstoreConn)
+	return client.invoke("r_kvstore", func() (interface{}, error) {
+		return do(client.r_kvstoreConn)
+	})
 }
 
 func (client *AliyunClient) NewQuotasClientV2() (*openapi.Client, error) {
@@ -2445,8 +2688,9 @@ func (client *AliyunClient) loadApiEndpoint(productCode string) (string, error)
 //	query - API parameters in query
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RpcPost(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.rpcRequest("POST", apiProductCode, apiVersion, apiName, query, body, autoRetry, "")
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RpcPost(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.rpcRequest("POST", apiProductCode, apiVersion, apiName, query, body, autoRetry, "", retryPolicy)
 }
 
 // RpcPostWithEndpoint invoking RPC API request with POST method and specified endpoint
@@ -2459,8 +2703,9 @@ func (client *AliyunClient) RpcPost(apiProductCode string, apiVersion string, ap
 //		body - API parameters in body
 //		autoRetry - whether to auto retry while the runtime has a 5xx error
 //	 endpoint - The domain of invoking api
-func (client *AliyunClient) RpcPostWithEndpoint(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string) (map[string]interface{}, error) {
-	return client.rpcRequest("POST", apiProductCode, apiVersion, apiName, query, body, autoRetry, endpoint)
+//		retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RpcPostWithEndpoint(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.rpcRequest("POST", apiProductCode, apiVersion, apiName, query, body, autoRetry, endpoint, retryPolicy)
 }
 
 // RpcGet invoking RPC API request with GET method
@@ -2471,11 +2716,12 @@ func (client *AliyunClient) RpcPostWithEndpoint(apiProductCode string, apiVersio
 //	apiName - API Name
 //	query - API parameters in query
 //	body - API parameters in body
-func (client *AliyunClient) RpcGet(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}) (map[string]interface{}, error) {
-	return client.rpcRequest("GET", apiProductCode, apiVersion, apiName, query, body, true, "")
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RpcGet(apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.rpcRequest("GET", apiProductCode, apiVersion, apiName, query, body, true, "", retryPolicy)
 }
 
-func (client *AliyunClient) rpcRequest(method string, apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string) (map[string]interface{}, error) {
+func (client *AliyunClient) rpcRequest(method string, apiProductCode string, apiVersion string, apiName string, query map[string]interface{}, body map[string]interface{}, autoRetry bool, endpoint string, retryPolicy []*RetryPolicy) (map[string]interface{}, error) {
 	var err error
 	if endpoint == "" {
 		apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
@@ -2484,23 +2730,30 @@ func (client *AliyunClient) rpcRequest(method string, apiProductCode string, api
 			return nil, err
 		}
 	}
-	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := rpc.NewClient(&sdkConfig)
+	conn, err := client.getTeaRpcClient(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
 	}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
-	response, err := conn.DoRequest(tea.String(apiName), nil, tea.String(method), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
-	return response, formatError(response, err)
+	limiter := client.getRateLimiter()
+	var response map[string]interface{}
+	start, attempts := time.Now(), 0
+	doRequest := func() error {
+		limiter.Wait(apiProductCode)
+		attempts++
+		response, err = conn.DoRequest(tea.String(apiName), nil, tea.String(method), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
+		err = formatError(response, err)
+		limiter.Observe(apiProductCode, err)
+		return err
+	}
+	if policy := client.getRetryPolicy(apiProductCode, retryPolicy...); policy != nil {
+		err = policy.Run(doRequest)
+	} else {
+		err = doRequest()
+	}
+	client.traceApi(apiProductCode, apiName, endpoint, start, attempts, response, err)
+	return response, err
 }
 
 // RoaPost invoking ROA API request with POST method
@@ -2513,8 +2766,9 @@ func (client *AliyunClient) rpcRequest(method string, apiProductCode string, api
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPost(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("POST", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPost(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("POST", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaPut invoking ROA API request with PUT method
@@ -2527,8 +2781,9 @@ func (client *AliyunClient) RoaPost(apiProductCode string, apiVersion string, pa
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPut(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("PUT", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPut(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("PUT", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaGet invoking ROA API request with GET method
@@ -2540,8 +2795,9 @@ func (client *AliyunClient) RoaPut(apiProductCode string, apiVersion string, pat
 //	query - API parameters in query
 //	headers - API parameters in headers
 //	body - API parameters in body
-func (client *AliyunClient) RoaGet(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}) (map[string]interface{}, error) {
-	return client.roaRequest("GET", apiProductCode, apiVersion, "", pathName, query, headers, body, true)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaGet(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("GET", apiProductCode, apiVersion, "", pathName, query, headers, body, true, retryPolicy)
 }
 
 // RoaDelete invoking ROA API request with DELETE method
@@ -2554,8 +2810,9 @@ func (client *AliyunClient) RoaGet(apiProductCode string, apiVersion string, pat
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaDelete(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("DELETE", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaDelete(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("DELETE", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaPatch invoking ROA API request with PATCH method
@@ -2568,8 +2825,9 @@ func (client *AliyunClient) RoaDelete(apiProductCode string, apiVersion string,
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPatch(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("PATCH", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPatch(apiProductCode string, apiVersion string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("PATCH", apiProductCode, apiVersion, "", pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaPostWithApiName invoking ROA API request with POST method
@@ -2583,8 +2841,9 @@ func (client *AliyunClient) RoaPatch(apiProductCode string, apiVersion string, p
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPostWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("POST", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPostWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("POST", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaPutWithApiName invoking ROA API request with PUT method
@@ -2598,8 +2857,9 @@ func (client *AliyunClient) RoaPostWithApiName(apiProductCode string, apiVersion
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPutWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("PUT", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPutWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("PUT", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaGetWithApiName invoking ROA API request with GET method
@@ -2612,8 +2872,9 @@ func (client *AliyunClient) RoaPutWithApiName(apiProductCode string, apiVersion
 //	query - API parameters in query
 //	headers - API parameters in headers
 //	body - API parameters in body
-func (client *AliyunClient) RoaGetWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}) (map[string]interface{}, error) {
-	return client.roaRequest("GET", apiProductCode, apiVersion, apiName, pathName, query, headers, body, true)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaGetWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("GET", apiProductCode, apiVersion, apiName, pathName, query, headers, body, true, retryPolicy)
 }
 
 // RoaDeleteWithApiName invoking ROA API request with DELETE method
@@ -2627,8 +2888,9 @@ func (client *AliyunClient) RoaGetWithApiName(apiProductCode string, apiVersion
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaDeleteWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("DELETE", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaDeleteWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("DELETE", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
 // RoaPatchWithApiName invoking ROA API request with PATCH method
@@ -2642,41 +2904,53 @@ func (client *AliyunClient) RoaDeleteWithApiName(apiProductCode string, apiVersi
 //	headers - API parameters in headers
 //	body - API parameters in body
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) RoaPatchWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
-	return client.roaRequest("PATCH", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry)
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) RoaPatchWithApiName(apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
+	return client.roaRequest("PATCH", apiProductCode, apiVersion, apiName, pathName, query, headers, body, autoRetry, retryPolicy)
 }
 
-func (client *AliyunClient) roaRequest(method string, apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool) (map[string]interface{}, error) {
+func (client *AliyunClient) roaRequest(method string, apiProductCode string, apiVersion string, apiName string, pathName string, query map[string]*string, headers map[string]*string, body interface{}, autoRetry bool, retryPolicy []*RetryPolicy) (map[string]interface{}, error) {
 	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
 	endpoint, err := client.loadApiEndpoint(apiProductCode)
 	if err != nil {
 		return nil, err
 	}
-	sdkConfig := client.teaRoaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := roa.NewClient(&sdkConfig)
+	conn, err := client.getTeaRoaClient(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
 	}
 	var response map[string]interface{}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
-	if apiName != "" {
-		response, err = conn.DoRequestWithAction(tea.String(apiName), tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathName), query, headers, body, runtime)
+	limiter := client.getRateLimiter()
+	start, attempts, requestId := time.Now(), 0, ""
+	doRequest := func() error {
+		limiter.Wait(apiProductCode)
+		attempts++
+		if apiName != "" {
+			response, err = conn.DoRequestWithAction(tea.String(apiName), tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathName), query, headers, body, runtime)
+		} else {
+			response, err = conn.DoRequest(tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathName), query, headers, body, runtime)
+		}
+		requestId = parseRequestId(response)
+		if respBody, isExist := response["body"]; isExist && respBody != nil {
+			response = respBody.(map[string]interface{})
+		}
+		err = formatError(response, err)
+		limiter.Observe(apiProductCode, err)
+		return err
+	}
+	if policy := client.getRetryPolicy(apiProductCode, retryPolicy...); policy != nil {
+		err = policy.Run(doRequest)
 	} else {
-		response, err = conn.DoRequest(tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathName), query, headers, body, runtime)
+		err = doRequest()
 	}
-	if respBody, isExist := response["body"]; isExist && respBody != nil {
-		response = respBody.(map[string]interface{})
+	action := apiName
+	if action == "" {
+		action = fmt.Sprintf("%s %s", method, pathName)
 	}
-	return response, formatError(response, err)
+	client.traceApi(apiProductCode, action, endpoint, start, attempts, withRequestId(response, requestId), err)
+	return response, err
 }
 
 // Do invoking API request with SDK v2
@@ -2689,7 +2963,8 @@ func (client *AliyunClient) roaRequest(method string, apiProductCode string, api
 //	headers - API parameters in headers
 //	hostMap - API parameters in hostMap
 //	autoRetry - whether to auto retry while the runtime has a 5xx error
-func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params, query map[string]*string, body interface{}, headers map[string]*string, hostMap map[string]*string, autoRetry bool) (map[string]interface{}, error) {
+//	retryPolicy - the optional policy to retry the request, see RetryPolicy
+func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params, query map[string]*string, body interface{}, headers map[string]*string, hostMap map[string]*string, autoRetry bool, retryPolicy ...*RetryPolicy) (map[string]interface{}, error) {
 	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
 	endpoint, err := client.loadApiEndpoint(apiProductCode)
 	if err != nil {
@@ -2741,17 +3016,33 @@ func (client *AliyunClient) Do(apiProductCode string, apiParams *openapi.Params,
 	var response map[string]interface{}
 	runtime := &utilV2.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
-	if apiParams.Style != nil && *apiParams.Style == "RPC" {
-		response, err = openapiClient.CallApi(apiParams, &openapi.OpenApiRequest{Query: query, Body: body, Headers: headers, HostMap: hostMap}, runtime)
-	} else {
-		response, err = openapiClient.Execute(apiParams, &openapi.OpenApiRequest{Query: query, Body: body, Headers: headers, HostMap: hostMap}, runtime)
-	}
-	if respBody, isExist := response["body"]; isExist && respBody != nil {
-		if v, ok := respBody.(map[string]interface{}); ok {
-			response = v
+	limiter := client.getRateLimiter()
+	start, attempts, requestId := time.Now(), 0, ""
+	doRequest := func() error {
+		limiter.Wait(apiProductCode)
+		attempts++
+		if apiParams.Style != nil && *apiParams.Style == "RPC" {
+			response, err = openapiClient.CallApi(apiParams, &openapi.OpenApiRequest{Query: query, Body: body, Headers: headers, HostMap: hostMap}, runtime)
+		} else {
+			response, err = openapiClient.Execute(apiParams, &openapi.OpenApiRequest{Query: query, Body: body, Headers: headers, HostMap: hostMap}, runtime)
+		}
+		requestId = parseRequestId(response)
+		if respBody, isExist := response["body"]; isExist && respBody != nil {
+			if v, ok := respBody.(map[string]interface{}); ok {
+				response = v
+			}
 		}
+		err = formatError(response, err)
+		limiter.Observe(apiProductCode, err)
+		return err
+	}
+	if policy := client.getRetryPolicy(apiProductCode, retryPolicy...); policy != nil {
+		err = policy.Run(doRequest)
+	} else {
+		err = doRequest()
 	}
-	return response, formatError(response, err)
+	client.traceApi(apiProductCode, tea.StringValue(apiParams.Action), endpoint, start, attempts, withRequestId(response, requestId), err)
+	return response, err
 }
 
 // applyOpenapiSignVersion writes the configured signature version (if any)
@@ -2918,3 +3209,11 @@ func (client *AliyunClient) GetRetryTimeout(defaultTimeout time.Duration) time.D
 
 	return defaultTimeout
 }
+
+// GetDefaultTags returns the tags configured by the provider default_tags, which will be merged into every taggable resource.
+func (client *AliyunClient) GetDefaultTags() map[string]interface{} {
+	if client == nil || client.config == nil {
+		return nil
+	}
+	return client.config.DefaultTags
+}
@@ -0,0 +1,169 @@
+package connectivity
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	roa "github.com/alibabacloud-go/tea-roa/client"
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// teaCredentialCheckInterval is the interval to re-check a refreshable credential, like the one of assume role,
+// ecs ram role or oidc role. The credential providers renew the credential minutes before it expires,
+// so it is safe to reuse the clients signed with the previous credential within the interval.
+const teaCredentialCheckInterval = time.Minute
+
+// teaClientPool caches the Tea RPC and ROA clients per endpoint and credential generation.
+// Building a Tea client per API call initializes a new credential provider and a new client object each time,
+// and the clients reuse the same http.Client and http.Transport which the Tea runtime keeps per domain,
+// so the pool keeps one client per endpoint until the credential rotates.
+type teaClientPool struct {
+	mutex      sync.RWMutex
+	generation uint64
+	credential teaCredential
+	static     bool
+	checkedAt  time.Time
+	rpcClients map[teaClientKey]*rpc.Client
+	roaClients map[teaClientKey]*roa.Client
+}
+
+type teaCredential struct {
+	accessKeyId     string
+	accessKeySecret string
+	securityToken   string
+}
+
+type teaClientKey struct {
+	endpoint   string
+	generation uint64
+}
+
+func newTeaClientPool() *teaClientPool {
+	return &teaClientPool{
+		rpcClients: make(map[teaClientKey]*rpc.Client),
+		roaClients: make(map[teaClientKey]*roa.Client),
+	}
+}
+
+// currentCredential returns the credential snapshot and its generation. The generation is bumped and
+// the cached clients are dropped once the credential returned by the provider changes.
+func (pool *teaClientPool) currentCredential(config *Config) (teaCredential, uint64, error) {
+	pool.mutex.RLock()
+	if pool.generation > 0 && (pool.static || time.Since(pool.checkedAt) < teaCredentialCheckInterval) {
+		credential, generation := pool.credential, pool.generation
+		pool.mutex.RUnlock()
+		return credential, generation, nil
+	}
+	pool.mutex.RUnlock()
+
+	if config == nil || config.Credential == nil {
+		return teaCredential{}, 0, fmt.Errorf("get credential failed. Error: the credential has not been initialized")
+	}
+	credential, err := config.Credential.GetCredential()
+	if err != nil || credential == nil {
+		return teaCredential{}, 0, fmt.Errorf("get credential failed. Error: %#v", err)
+	}
+	current := teaCredential{
+		accessKeyId:     tea.StringValue(credential.AccessKeyId),
+		accessKeySecret: tea.StringValue(credential.AccessKeySecret),
+		securityToken:   tea.StringValue(credential.SecurityToken),
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	if pool.generation == 0 || pool.credential != current {
+		pool.generation++
+		pool.credential = current
+		pool.rpcClients = make(map[teaClientKey]*rpc.Client)
+		pool.roaClients = make(map[teaClientKey]*roa.Client)
+	}
+	credentialType := tea.StringValue(credential.Type)
+	pool.static = credentialType == "access_key" || credentialType == "sts"
+	pool.checkedAt = time.Now()
+	return pool.credential, pool.generation, nil
+}
+
+func (client *AliyunClient) getTeaClientPool() *teaClientPool {
+	client.teaClientPoolOnce.Do(func() {
+		if client.teaClients == nil {
+			client.teaClients = newTeaClientPool()
+		}
+	})
+	return client.teaClients
+}
+
+// getTeaRpcClient returns a cached Tea RPC client for the endpoint, and builds one if there is no client
+// for the endpoint and the current credential.
+func (client *AliyunClient) getTeaRpcClient(endpoint string) (*rpc.Client, error) {
+	pool := client.getTeaClientPool()
+	credential, generation, err := pool.currentCredential(client.config)
+	if err != nil {
+		return nil, err
+	}
+	key := teaClientKey{endpoint: endpoint, generation: generation}
+	pool.mutex.RLock()
+	conn, ok := pool.rpcClients[key]
+	pool.mutex.RUnlock()
+	if ok {
+		return conn, nil
+	}
+
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetAccessKeyId(credential.accessKeyId)
+	sdkConfig.SetAccessKeySecret(credential.accessKeySecret)
+	sdkConfig.SetSecurityToken(credential.securityToken)
+	conn, err = rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	if existing, ok := pool.rpcClients[key]; ok {
+		return existing, nil
+	}
+	if generation == pool.generation {
+		pool.rpcClients[key] = conn
+	}
+	return conn, nil
+}
+
+// getTeaRoaClient returns a cached Tea ROA client for the endpoint, and builds one if there is no client
+// for the endpoint and the current credential.
+func (client *AliyunClient) getTeaRoaClient(endpoint string) (*roa.Client, error) {
+	pool := client.getTeaClientPool()
+	credential, generation, err := pool.currentCredential(client.config)
+	if err != nil {
+		return nil, err
+	}
+	key := teaClientKey{endpoint: endpoint, generation: generation}
+	pool.mutex.RLock()
+	conn, ok := pool.roaClients[key]
+	pool.mutex.RUnlock()
+	if ok {
+		return conn, nil
+	}
+
+	sdkConfig := client.teaRoaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetAccessKeyId(credential.accessKeyId)
+	sdkConfig.SetAccessKeySecret(credential.accessKeySecret)
+	sdkConfig.SetSecurityToken(credential.securityToken)
+	conn, err = roa.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	if existing, ok := pool.roaClients[key]; ok {
+		return existing, nil
+	}
+	if generation == pool.generation {
+		pool.roaClients[key] = conn
+	}
+	return conn, nil
+}
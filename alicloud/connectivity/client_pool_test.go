@@ -0,0 +1,167 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTeaPoolTestClient builds a client whose ECS and CS endpoints point to a local fake server.
+func newTeaPoolTestClient(t testing.TB, cred *mockCredential) (*AliyunClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"RequestId":"B5F1C4E2-4C3D-4B5A-9E3A-8F1D2C3B4A5E"}`)
+	}))
+	config := &Config{
+		AccessKey:            "test-ak",
+		SecretKey:            "test-sk",
+		Region:               Hangzhou,
+		RegionId:             string(Hangzhou),
+		Protocol:             "HTTP",
+		SkipRegionValidation: true,
+		Credential:           cred,
+		Endpoints:            &sync.Map{},
+	}
+	endpoint := strings.TrimPrefix(server.URL, "http://")
+	config.Endpoints.Store("ecs", endpoint)
+	config.Endpoints.Store("cs", endpoint)
+
+	teaSdkConfig, err := config.getTeaDslSdkConfig(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	teaRoaSdkConfig, err := config.getTeaRoaDslSdkConfig(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &AliyunClient{
+		config:          config,
+		teaSdkConfig:    teaSdkConfig,
+		teaRoaSdkConfig: teaRoaSdkConfig,
+		teaClients:      newTeaClientPool(),
+	}
+	return client, server
+}
+
+func TestUnitCommonTeaClientPoolReuse(t *testing.T) {
+	cred := &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"}
+	client, server := newTeaPoolTestClient(t, cred)
+	defer server.Close()
+
+	rpcClient, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	again, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.True(t, rpcClient == again, "the rpc client should be reused for the same endpoint")
+	other, err := client.getTeaRpcClient("ecs.cn-hangzhou.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.False(t, rpcClient == other, "each endpoint should have its own rpc client")
+
+	roaClient, err := client.getTeaRoaClient("cs.aliyuncs.com")
+	assert.Nil(t, err)
+	roaAgain, err := client.getTeaRoaClient("cs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.True(t, roaClient == roaAgain, "the roa client should be reused for the same endpoint")
+}
+
+func TestUnitCommonTeaClientPoolCredentialRotation(t *testing.T) {
+	cred := &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk", securityToken: "token-1"}
+	client, server := newTeaPoolTestClient(t, cred)
+	defer server.Close()
+
+	before, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", client.teaClients.credential.securityToken)
+	generation := client.teaClients.generation
+
+	// The credential is not re-checked within the interval.
+	cred.securityToken = "token-2"
+	cached, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.True(t, before == cached)
+
+	client.teaClients.checkedAt = time.Now().Add(-2 * teaCredentialCheckInterval)
+	after, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.False(t, before == after, "a new client should be built after the credential rotated")
+	assert.Equal(t, "token-2", client.teaClients.credential.securityToken)
+	assert.Equal(t, generation+1, client.teaClients.generation)
+
+	// An unchanged credential keeps the generation.
+	client.teaClients.checkedAt = time.Now().Add(-2 * teaCredentialCheckInterval)
+	unchanged, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.Nil(t, err)
+	assert.True(t, after == unchanged)
+	assert.Equal(t, generation+1, client.teaClients.generation)
+}
+
+func TestUnitCommonTeaClientPoolCredentialError(t *testing.T) {
+	cred := &mockCredential{err: fmt.Errorf("credential is unavailable")}
+	client, server := newTeaPoolTestClient(t, cred)
+	defer server.Close()
+
+	_, err := client.getTeaRpcClient("ecs.aliyuncs.com")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "get credential failed")
+}
+
+func TestUnitCommonTeaClientPoolConcurrent(t *testing.T) {
+	cred := &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"}
+	client, server := newTeaPoolTestClient(t, cred)
+	defer server.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, err := client.RpcPost("Ecs", "2014-05-26", "DescribeRegions", nil, nil, false)
+				assert.Nil(t, err)
+				_, err = client.RoaGet("CS", "2015-12-15", "/regions/cn-hangzhou/clusters", nil, nil, nil)
+				assert.Nil(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, client.teaClients.rpcClients, 1)
+	assert.Len(t, client.teaClients.roaClients, 1)
+}
+
+func BenchmarkRpcPost(b *testing.B) {
+	cred := &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"}
+	client, server := newTeaPoolTestClient(b, cred)
+	defer server.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := client.RpcPost("Ecs", "2014-05-26", "DescribeRegions", nil, nil, false); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkRoaGet(b *testing.B) {
+	cred := &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"}
+	client, server := newTeaPoolTestClient(b, cred)
+	defer server.Close()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := client.RoaGet("CS", "2015-12-15", "/regions/cn-hangzhou/clusters", nil, nil, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
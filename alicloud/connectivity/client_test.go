@@ -1,8 +1,13 @@
 package connectivity
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -225,3 +230,50 @@ func TestUnitCommonWithRamClient_Proxy(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitCommonValidateAccountId(t *testing.T) {
+	assert.Nil(t, validateAccountId("123456789012", nil, nil))
+	assert.Nil(t, validateAccountId("123456789012", []string{"123456789012", "210987654321"}, nil))
+	assert.Nil(t, validateAccountId("123456789012", nil, []string{"210987654321"}))
+
+	err := validateAccountId("123456789012", []string{"210987654321"}, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "123456789012 of the provider credential is not in the allowed_account_ids")
+
+	err = validateAccountId("123456789012", nil, []string{"123456789012"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "123456789012 of the provider credential is in the forbidden_account_ids")
+}
+
+func TestUnitCommonValidateAccountIdWithCallerIdentity(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"RequestId":"C5E3A1B2-0000-0000-0000-000000000000","AccountId":"123456789012","UserId":"200000000000000000","Arn":"acs:ram::123456789012:user/terraform","IdentityType":"RAMUser","PrincipalId":"200000000000000000"}`)
+	}))
+	defer server.Close()
+
+	client, fakeServer := newTeaPoolTestClient(t, &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"})
+	fakeServer.Close()
+	client.config.StsEndpoint = strings.TrimPrefix(server.URL, "http://")
+	client.config.AccountId = "210987654321"
+
+	assert.Nil(t, client.ValidateAccountId())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests), "the caller identity should not be queried without any guard")
+
+	client.config.AllowedAccountIds = []string{"123456789012"}
+	assert.Nil(t, client.ValidateAccountId())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	client.config.AllowedAccountIds = []string{"210987654321"}
+	err := client.ValidateAccountId()
+	assert.NotNil(t, err, "the stale account_id should not be trusted")
+	assert.Contains(t, err.Error(), "not in the allowed_account_ids")
+
+	client.config.AllowedAccountIds = nil
+	client.config.ForbiddenAccountIds = []string{"123456789012"}
+	err = client.ValidateAccountId()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "in the forbidden_account_ids")
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	credential "github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
 )
 
 var securityCredURL = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
@@ -32,6 +33,8 @@ type Config struct {
 	OtsInstanceName      string
 	AccountId            string
 	AccountType          string
+	AllowedAccountIds    []string
+	ForbiddenAccountIds  []string
 	Protocol             string
 	ClientReadTimeout    int
 	ClientConnectTimeout int
@@ -39,13 +42,24 @@ type Config struct {
 	SecureTransport      string
 	MaxRetryTimeout      int
 	Credential           credential.Credential
+	DefaultTags          map[string]interface{}
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+	ApiRateLimits        map[string]ApiRateLimit
+	RetryPolicies        map[string]RetryPolicy
+	ApiTraceFile         string
+	EndpointType         string
+	ProductEndpointTypes map[string]string
 
 	RamRoleArn               string
 	RamRoleSessionName       string
 	RamRolePolicy            string
 	RamRoleExternalId        string
 	RamRoleSessionExpiration int
+	AssumeRoleChain          []AssumeRoleHop
 	AssumeRoleWithOidc       *AssumeRoleWithOidc
+	CredentialProcess        string
+	credentialProcess        *CredentialProcessProvider
 	Endpoints                *sync.Map
 	SignVersion              *sync.Map
 	RKvstoreEndpoint         string
@@ -202,6 +216,15 @@ type AssumeRoleWithOidc struct {
 	OIDCToken       string
 }
 
+// AssumeRoleHop is one of the RAM roles in the provider assume_role chain.
+type AssumeRoleHop struct {
+	RoleArn           string
+	SessionName       string
+	Policy            string
+	ExternalId        string
+	SessionExpiration int
+}
+
 func (c *Config) loadAndValidate() error {
 	err := c.validateRegion()
 	if err != nil {
@@ -256,6 +279,10 @@ func (c *Config) setAuthByAssumeRole() (err error) {
 	if c.AccessKey == "" || c.RamRoleArn == "" {
 		return
 	}
+	// the credential of credential_process expires, so the roles are assumed by the provider which refreshes it
+	if len(c.AssumeRoleChain) > 1 || (c.credentialProcess != nil && len(c.AssumeRoleChain) > 0) {
+		return c.setAuthByAssumeRoleChain()
+	}
 
 	config := new(credential.Config).
 		SetType("ram_role_arn").
@@ -291,6 +318,85 @@ func (c *Config) setAuthByAssumeRole() (err error) {
 	return nil
 }
 
+// setAuthByAssumeRoleChain assumes the roles in the assume_role chain one by one, and each role is assumed by the
+// credential of the previous one. Every role caches its own sts credential and refreshes it independently before
+// it expires, so the final credential can be refreshed without assuming all of the roles again.
+func (c *Config) setAuthByAssumeRoleChain() (err error) {
+	var previous providers.CredentialsProvider
+	if c.credentialProcess != nil {
+		previous = c.credentialProcess
+	} else if c.SecurityToken != "" {
+		previous, err = providers.NewStaticSTSCredentialsProviderBuilder().
+			WithAccessKeyId(c.AccessKey).
+			WithAccessKeySecret(c.SecretKey).
+			WithSecurityToken(c.SecurityToken).
+			Build()
+	} else {
+		previous, err = providers.NewStaticAKCredentialsProviderBuilder().
+			WithAccessKeyId(c.AccessKey).
+			WithAccessKeySecret(c.SecretKey).
+			Build()
+	}
+	if err != nil {
+		return err
+	}
+	httpOptions := &providers.HttpOptions{
+		ConnectTimeout: c.ClientConnectTimeout,
+		ReadTimeout:    c.ClientReadTimeout,
+	}
+	for i, hop := range c.AssumeRoleChain {
+		builder := providers.NewRAMRoleARNCredentialsProviderBuilder().
+			WithCredentialsProvider(previous).
+			WithRoleArn(hop.RoleArn).
+			WithRoleSessionName(hop.SessionName).
+			WithPolicy(hop.Policy).
+			WithExternalId(hop.ExternalId).
+			WithDurationSeconds(hop.SessionExpiration).
+			WithHttpOptions(httpOptions)
+		if c.StsEndpoint != "" {
+			builder.WithStsEndpoint(c.StsEndpoint)
+		}
+		provider, err := builder.Build()
+		if err != nil {
+			return fmt.Errorf("building the credential of assume_role.%d %s failed. Error: %v", i, hop.RoleArn, err)
+		}
+		// assuming the roles in order makes the error point to the role which can not be assumed
+		if _, err := provider.GetCredentials(); err != nil {
+			return fmt.Errorf("refresh Ram Role Arn credential of assume_role.%d %s failed. Error: %v", i, hop.RoleArn, err)
+		}
+		previous = provider
+	}
+	c.Credential = credential.FromCredentialsProvider("ram_role_arn", previous)
+	credential, err := c.Credential.GetCredential()
+	if err != nil || credential == nil {
+		return fmt.Errorf("refresh Ram Role Arn credential failed. Error: %v", err)
+	}
+	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
+	return nil
+}
+
+// setAuthCredentialByProcess runs the credential_process command to get the sts credential. The command is run
+// again by the provider when the credential is about to expire, so refreshing returns the cached one until then.
+func (c *Config) setAuthCredentialByProcess() (err error) {
+	if c.CredentialProcess == "" || (c.AccessKey != "" && c.credentialProcess == nil) {
+		return
+	}
+	if c.credentialProcess == nil {
+		c.credentialProcess, err = NewCredentialProcessProvider(c.CredentialProcess, time.Duration(c.ClientReadTimeout)*time.Millisecond)
+		if err != nil {
+			return err
+		}
+	}
+	c.Credential = credential.FromCredentialsProvider(c.credentialProcess.GetProviderName(), c.credentialProcess)
+	credential, err := c.Credential.GetCredential()
+	if err != nil || credential == nil {
+		return fmt.Errorf("refresh credential_process credential failed. Error: %v", err)
+	}
+
+	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
+	return
+}
+
 // setAuthCredentialByEcsRoleName aims to access meta to get sts credential
 // Actually, the job should be done by sdk, but currently not all resources and products support alibaba-cloud-sdk-go,
 // and their go sdk does support ecs role name.
@@ -454,6 +560,9 @@ func isExpectedErrors(err error, expectCodes []string) bool {
 	return false
 }
 func (c *Config) RefreshAuthCredential() error {
+	if err := c.setAuthCredentialByProcess(); err != nil {
+		return err
+	}
 	if err := c.setAuthCredentialByEcsRoleName(); err != nil {
 		return err
 	}
@@ -473,6 +582,9 @@ func (c *Config) getTeaDslSdkConfig(stsSupported bool) (config rpc.Config, err e
 		SetReadTimeout(c.ClientReadTimeout).
 		SetConnectTimeout(c.ClientConnectTimeout).
 		SetMaxIdleConns(500)
+	if proxy := getRecorderProxy(); proxy != nil {
+		config.SetHttpProxy(proxy.String())
+	}
 	if c.SourceIp != "" {
 		config.SetSourceIp(c.SourceIp)
 	}
@@ -492,6 +604,9 @@ func (c *Config) getTeaRoaDslSdkConfig(stsSupported bool) (config roa.Config, er
 		SetReadTimeout(c.ClientReadTimeout).
 		SetConnectTimeout(c.ClientConnectTimeout).
 		SetMaxIdleConns(500)
+	if proxy := getRecorderProxy(); proxy != nil {
+		config.SetHttpProxy(proxy.String())
+	}
 	if c.SourceIp != "" {
 		config.SetSourceIp(c.SourceIp)
 	}
@@ -510,6 +625,9 @@ func (c *Config) getTeaRpcOpenapiConfig(stsSupported bool) (config openapi.Confi
 		SetReadTimeout(c.ClientReadTimeout).
 		SetConnectTimeout(c.ClientConnectTimeout).
 		SetMaxIdleConns(500)
+	if proxy := getRecorderProxy(); proxy != nil {
+		config.SetHttpProxy(proxy.String())
+	}
 
 	query := map[string]*string{
 		"AcceptLanguage": tea.String("en-US"),
@@ -535,6 +653,9 @@ func (c *Config) getTeaRoaOpenapiConfig(stsSupported bool) (config openapi.Confi
 		SetReadTimeout(c.ClientReadTimeout).
 		SetConnectTimeout(c.ClientConnectTimeout).
 		SetMaxIdleConns(500)
+	if proxy := getRecorderProxy(); proxy != nil {
+		config.SetHttpProxy(proxy.String())
+	}
 
 	header := make(map[string]*string)
 	if c.SourceIp != "" {
@@ -3,7 +3,13 @@ package connectivity
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/alibabacloud-go/tea/tea"
 	sdkCredentials "github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
@@ -754,6 +760,146 @@ func TestUnitCommonSetAuthByAssumeRole_WithSecurityToken(t *testing.T) {
 	}
 }
 
+// fakeSts is a fake STS endpoint which issues the credential "STS.<role name>" for AssumeRole, and records the
+// access key which calls AssumeRole for every role.
+type fakeSts struct {
+	mutex       sync.Mutex
+	expirations map[string]time.Duration
+	callers     map[string][]string
+	requests    []url.Values
+}
+
+func newFakeStsServer(t *testing.T, expirations map[string]time.Duration) (*fakeSts, string) {
+	sts := &fakeSts{expirations: expirations, callers: map[string][]string{}}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("Action") != "AssumeRole" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"Code":"InvalidAction.NotFound","RequestId":"A1B2C3D4-0000-0000-0000-000000000000"}`)
+			return
+		}
+		roleArn := r.Form.Get("RoleArn")
+		roleName := roleArn[strings.LastIndex(roleArn, "/")+1:]
+		sts.mutex.Lock()
+		sts.callers[roleName] = append(sts.callers[roleName], r.Form.Get("AccessKeyId"))
+		sts.requests = append(sts.requests, r.Form)
+		expiration, ok := sts.expirations[roleName]
+		sts.mutex.Unlock()
+		if !ok {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{"Code":"NoPermission","Message":"You are not authorized to assume the role %s.","RequestId":"A1B2C3D4-0000-0000-0000-000000000000"}`, roleArn)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"RequestId":"A1B2C3D4-0000-0000-0000-000000000000","Credentials":{"AccessKeyId":"STS.%s","AccessKeySecret":"secret-%s","SecurityToken":"token-%s","Expiration":"%s"}}`,
+			roleName, roleName, roleName, time.Now().Add(expiration).UTC().Format("2006-01-02T15:04:05Z"))
+	}))
+	t.Cleanup(server.Close)
+
+	// the credentials sdk always requests sts with https and the transport cloned from the default one
+	transport := http.DefaultTransport.(*http.Transport)
+	tlsClientConfig := transport.TLSClientConfig
+	transport.TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+	t.Cleanup(func() {
+		transport.TLSClientConfig = tlsClientConfig
+	})
+	return sts, strings.TrimPrefix(server.URL, "https://")
+}
+
+func newAssumeRoleChainTestConfig(stsEndpoint string, roleNames ...string) *Config {
+	config := &Config{
+		AccessKey:   "management-ak",
+		SecretKey:   "management-sk",
+		StsEndpoint: stsEndpoint,
+	}
+	for _, roleName := range roleNames {
+		config.AssumeRoleChain = append(config.AssumeRoleChain, AssumeRoleHop{
+			RoleArn:           "acs:ram::123456789012:role/" + roleName,
+			SessionName:       "terraform",
+			SessionExpiration: 3600,
+		})
+	}
+	config.RamRoleArn = config.AssumeRoleChain[0].RoleArn
+	return config
+}
+
+func TestUnitCommonSetAuthByAssumeRoleChain(t *testing.T) {
+	sts, endpoint := newFakeStsServer(t, map[string]time.Duration{
+		"security": time.Hour,
+		"workload": time.Hour,
+	})
+	config := newAssumeRoleChainTestConfig(endpoint, "security", "workload")
+	config.AssumeRoleChain[0].SessionName = "management"
+	config.AssumeRoleChain[0].Policy = `{"Version":"1","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Resource":"*"}]}`
+	config.AssumeRoleChain[1].ExternalId = "landing-zone"
+	config.AssumeRoleChain[1].SessionExpiration = 900
+
+	err := config.setAuthByAssumeRole()
+	assert.Nil(t, err)
+	assert.Equal(t, "STS.workload", config.AccessKey)
+	assert.Equal(t, "secret-workload", config.SecretKey)
+	assert.Equal(t, "token-workload", config.SecurityToken)
+	assert.Equal(t, "ram_role_arn", tea.StringValue(config.Credential.GetType()))
+	assert.True(t, config.needRefreshCredential())
+
+	assert.Equal(t, []string{"management-ak"}, sts.callers["security"], "the first role should be assumed by the provider credential")
+	assert.Equal(t, []string{"STS.security"}, sts.callers["workload"], "the next role should be assumed by the previous role")
+	assert.Len(t, sts.requests, 2)
+	assert.Equal(t, "management", sts.requests[0].Get("RoleSessionName"))
+	assert.Contains(t, sts.requests[0].Get("Policy"), "sts:AssumeRole")
+	assert.Empty(t, sts.requests[0].Get("ExternalId"))
+	assert.Equal(t, "landing-zone", sts.requests[1].Get("ExternalId"))
+	assert.Equal(t, "900", sts.requests[1].Get("DurationSeconds"))
+	assert.Equal(t, "token-security", sts.requests[1].Get("SecurityToken"))
+}
+
+func TestUnitCommonSetAuthByAssumeRoleChain_IndependentRefresh(t *testing.T) {
+	// the workload credential expires within the refresh window, so it is refreshed on every call
+	sts, endpoint := newFakeStsServer(t, map[string]time.Duration{
+		"security": time.Hour,
+		"audit":    time.Hour,
+		"workload": time.Minute,
+	})
+	config := newAssumeRoleChainTestConfig(endpoint, "security", "audit", "workload")
+
+	assert.Nil(t, config.setAuthByAssumeRole())
+	for i := 0; i < 3; i++ {
+		accessKey, _, securityToken := config.GetRefreshCredential()
+		assert.Equal(t, "STS.workload", accessKey)
+		assert.Equal(t, "token-workload", securityToken)
+	}
+	assert.Len(t, sts.callers["security"], 1, "the intermediate credential should be cached until it expires")
+	assert.Len(t, sts.callers["audit"], 1, "the intermediate credential should be cached until it expires")
+	// assumed by the chain, by reading the provider credential, and by each of the refreshes
+	assert.Equal(t, []string{"STS.audit", "STS.audit", "STS.audit", "STS.audit", "STS.audit"}, sts.callers["workload"])
+}
+
+func TestUnitCommonSetAuthByAssumeRoleChain_Error(t *testing.T) {
+	sts, endpoint := newFakeStsServer(t, map[string]time.Duration{
+		"security": time.Hour,
+	})
+	config := newAssumeRoleChainTestConfig(endpoint, "security", "workload", "audit")
+
+	err := config.setAuthByAssumeRole()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "assume_role.1 acs:ram::123456789012:role/workload")
+	assert.Contains(t, err.Error(), "NoPermission")
+	assert.Equal(t, "management-ak", config.AccessKey, "the provider credential should not be changed")
+	assert.Empty(t, sts.callers["audit"], "the roles after the failed one should not be assumed")
+}
+
+func TestUnitCommonSetAuthByAssumeRoleChain_SingleRole(t *testing.T) {
+	sts, endpoint := newFakeStsServer(t, map[string]time.Duration{
+		"security": time.Hour,
+	})
+	config := newAssumeRoleChainTestConfig(endpoint, "security")
+	config.RamRoleSessionName = "terraform"
+	config.RamRoleSessionExpiration = 3600
+
+	assert.Nil(t, config.setAuthByAssumeRole())
+	assert.Equal(t, "STS.security", config.AccessKey)
+	assert.Equal(t, []string{"management-ak"}, sts.callers["security"])
+}
+
 func TestUnitCommonSetAuthCredentialByEcsRoleName_WithAccessKey(t *testing.T) {
 	config := &Config{
 		AccessKey:   "existing-ak",
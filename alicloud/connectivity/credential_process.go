@@ -0,0 +1,137 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+const (
+	// DefaultCredentialProcessTimeout bounds the running time of the credential_process command.
+	DefaultCredentialProcessTimeout = time.Minute
+	// CredentialProcessRefreshWindow is how long before the expiration the credential is fetched again.
+	CredentialProcessRefreshWindow = 3 * time.Minute
+)
+
+// credentialProcessOutput is the sts credential printed by the credential_process command. The credential can also
+// be wrapped by Credentials, like the response of the sts AssumeRole.
+type credentialProcessOutput struct {
+	AccessKeyId     string
+	AccessKeySecret string
+	SecurityToken   string
+	Expiration      string
+	Credentials     *credentialProcessOutput
+}
+
+// CredentialProcessProvider runs an external command which prints the credential as JSON, and caches the credential
+// until shortly before it expires. A credential without expiration is cached until the provider exits.
+type CredentialProcessProvider struct {
+	command string
+	timeout time.Duration
+
+	mutex       sync.Mutex
+	credentials *providers.Credentials
+	expiration  time.Time
+
+	run func(ctx context.Context, command string) ([]byte, error)
+	now func() time.Time
+}
+
+// NewCredentialProcessProvider returns the provider running the command by the shell. A zero timeout means
+// DefaultCredentialProcessTimeout.
+func NewCredentialProcessProvider(command string, timeout time.Duration) (*CredentialProcessProvider, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, fmt.Errorf("the credential_process command is empty")
+	}
+	if timeout <= 0 {
+		timeout = DefaultCredentialProcessTimeout
+	}
+	return &CredentialProcessProvider{
+		command: command,
+		timeout: timeout,
+		run:     runCredentialProcess,
+		now:     time.Now,
+	}, nil
+}
+
+func (p *CredentialProcessProvider) GetProviderName() string {
+	return "credential_process"
+}
+
+func (p *CredentialProcessProvider) GetCredentials() (*providers.Credentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.credentials != nil && (p.expiration.IsZero() || p.now().Add(CredentialProcessRefreshWindow).Before(p.expiration)) {
+		return p.credentials, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	stdout, err := p.run(ctx, p.command)
+	if ctx.Err() == context.DeadlineExceeded {
+		return nil, fmt.Errorf("running credential_process timed out after %s", p.timeout)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("running credential_process failed. Error: %v", err)
+	}
+	credentials, expiration, err := parseCredentialProcessOutput(stdout)
+	if err != nil {
+		return nil, err
+	}
+	credentials.ProviderName = p.GetProviderName()
+	p.credentials, p.expiration = credentials, expiration
+	return p.credentials, nil
+}
+
+// parseCredentialProcessOutput parses the credential printed by the command. The expiration is zero if it is not set.
+func parseCredentialProcessOutput(stdout []byte) (*providers.Credentials, time.Time, error) {
+	var output credentialProcessOutput
+	if err := json.Unmarshal(bytes.TrimSpace(stdout), &output); err != nil {
+		return nil, time.Time{}, fmt.Errorf("parsing the output of credential_process failed. Error: %v", err)
+	}
+	if output.Credentials != nil {
+		output = *output.Credentials
+	}
+	if output.AccessKeyId == "" || output.AccessKeySecret == "" {
+		return nil, time.Time{}, fmt.Errorf("the output of credential_process does not contain AccessKeyId and AccessKeySecret")
+	}
+	var expiration time.Time
+	if output.Expiration != "" {
+		t, err := time.Parse(time.RFC3339, output.Expiration)
+		if err != nil {
+			return nil, time.Time{}, fmt.Errorf("parsing the Expiration %q of credential_process failed. Error: %v", output.Expiration, err)
+		}
+		expiration = t
+	}
+	return &providers.Credentials{
+		AccessKeyId:     output.AccessKeyId,
+		AccessKeySecret: output.AccessKeySecret,
+		SecurityToken:   output.SecurityToken,
+	}, expiration, nil
+}
+
+// runCredentialProcess runs the command by the shell, and returns its stdout. The stderr is attached to the error.
+func runCredentialProcess(ctx context.Context, command string) ([]byte, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+	cmd.Env = os.Environ()
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
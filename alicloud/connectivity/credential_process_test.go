@@ -0,0 +1,125 @@
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCredentialProcessProvider(t *testing.T, clock *fakeClock, outputs ...string) (*CredentialProcessProvider, *int) {
+	provider, err := NewCredentialProcessProvider("secrets-broker sts", 0)
+	assert.NoError(t, err)
+	runs := 0
+	provider.now = clock.Now
+	provider.run = func(ctx context.Context, command string) ([]byte, error) {
+		assert.Equal(t, "secrets-broker sts", command)
+		output := outputs[runs]
+		runs++
+		if output == "" {
+			return nil, errors.New("exit status 1: access denied")
+		}
+		return []byte(output), nil
+	}
+	return provider, &runs
+}
+
+func testCredentialProcessOutput(accessKeyId string, expiration time.Time) string {
+	return fmt.Sprintf(`{"AccessKeyId": %q, "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": %q}`,
+		accessKeyId, expiration.UTC().Format(time.RFC3339))
+}
+
+func TestUnitCommonCredentialProcessProviderCache(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	provider, runs := newTestCredentialProcessProvider(t, clock,
+		testCredentialProcessOutput("STS.first", clock.now.Add(time.Hour)),
+		testCredentialProcessOutput("STS.second", clock.now.Add(2*time.Hour)),
+		"",
+	)
+
+	credentials, err := provider.GetCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "STS.first", credentials.AccessKeyId)
+	assert.Equal(t, "secret", credentials.AccessKeySecret)
+	assert.Equal(t, "token", credentials.SecurityToken)
+	assert.Equal(t, "credential_process", credentials.ProviderName)
+
+	// the credential is cached until the refresh window before the expiration
+	clock.now = clock.now.Add(time.Hour - CredentialProcessRefreshWindow - time.Second)
+	credentials, err = provider.GetCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "STS.first", credentials.AccessKeyId)
+	assert.Equal(t, 1, *runs)
+
+	clock.now = clock.now.Add(time.Second)
+	credentials, err = provider.GetCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "STS.second", credentials.AccessKeyId)
+	assert.Equal(t, 2, *runs)
+
+	clock.now = clock.now.Add(2 * time.Hour)
+	_, err = provider.GetCredentials()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "access denied")
+}
+
+func TestUnitCommonCredentialProcessProviderWithoutExpiration(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	provider, runs := newTestCredentialProcessProvider(t, clock,
+		`{"Credentials": {"AccessKeyId": "LTAI.static", "AccessKeySecret": "secret"}}`,
+	)
+	credentials, err := provider.GetCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "LTAI.static", credentials.AccessKeyId)
+	assert.Equal(t, "", credentials.SecurityToken)
+
+	clock.now = clock.now.Add(24 * time.Hour)
+	_, err = provider.GetCredentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *runs)
+}
+
+func TestUnitCommonParseCredentialProcessOutput(t *testing.T) {
+	_, expiration, err := parseCredentialProcessOutput([]byte(`{"AccessKeyId": "STS.a", "AccessKeySecret": "b", "Expiration": "2025-01-01T08:00:00+08:00"}`))
+	assert.NoError(t, err)
+	assert.True(t, expiration.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	_, _, err = parseCredentialProcessOutput([]byte(`access denied`))
+	assert.Error(t, err)
+	_, _, err = parseCredentialProcessOutput([]byte(`{"AccessKeyId": "STS.a"}`))
+	assert.Error(t, err)
+	_, _, err = parseCredentialProcessOutput([]byte(`{"AccessKeyId": "STS.a", "AccessKeySecret": "b", "Expiration": "tomorrow"}`))
+	assert.Error(t, err)
+
+	_, err = NewCredentialProcessProvider(" ", 0)
+	assert.Error(t, err)
+}
+
+func TestUnitCommonRefreshAuthCredential_CredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the command is written for sh")
+	}
+	config := &Config{
+		CredentialProcess: `echo '{"AccessKeyId": "STS.process", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "2099-12-31T23:59:59Z"}'`,
+		EcsRoleName:       "ignored-role",
+	}
+	assert.NoError(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.process", config.AccessKey)
+	assert.Equal(t, "secret", config.SecretKey)
+	assert.Equal(t, "token", config.SecurityToken)
+	assert.Equal(t, "credential_process", *config.Credential.GetType())
+	assert.True(t, config.needRefreshCredential())
+
+	// refreshing again uses the same provider instead of the static credential
+	assert.NoError(t, config.RefreshAuthCredential())
+	assert.Equal(t, "STS.process", config.AccessKey)
+
+	config = &Config{CredentialProcess: "echo failed >&2; exit 1"}
+	err := config.RefreshAuthCredential()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed")
+}
@@ -570,7 +570,7 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if strings.Contains(endpointFmt, "%s") {
 			endpointFmt = fmt.Sprintf(endpointFmt, client.RegionId)
 		}
-		client.config.Endpoints.Store(productCode, endpointFmt)
+		client.config.Endpoints.Store(productCode, client.convertEndpointByType(productCode, endpointFmt))
 		return nil
 	}
 
@@ -583,7 +583,7 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if v, ok := regularProductEndpointReplace[endpoint]; ok {
 			endpoint = v
 		}
-		client.config.Endpoints.Store(strings.ToLower(productCode), endpoint)
+		client.config.Endpoints.Store(strings.ToLower(productCode), client.convertEndpointByType(productCode, endpoint))
 	} else if endpointFmt, ok := regularProductEndpoint[productCode]; ok {
 		if v, ok := regularProductEndpointForIntlRegion[productCode]; ok && client.isInternationalRegion() {
 			endpointFmt = v
@@ -600,6 +600,7 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		if v, ok := regularProductEndpointReplace[endpointFmt]; ok {
 			endpointFmt = v
 		}
+		endpointFmt = client.convertEndpointByType(productCode, endpointFmt)
 		client.config.Endpoints.Store(productCode, endpointFmt)
 		log.Printf("[WARN] loading %s endpoint got an error: %#v. Using the endpoint %s instead.", productCode, err, endpointFmt)
 		return nil
@@ -607,6 +608,98 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 	return err
 }
 
+const (
+	EndpointTypePublic   = "public"
+	EndpointTypeVpc      = "vpc"
+	EndpointTypeIntranet = "intranet"
+)
+
+// publicOnlyProductEndpoint records those product codes whose APIs have no VPC or intranet endpoint.
+// Key: product code, its value equals to the gateway code of the API after converting it to lowercase and using underscores
+var publicOnlyProductEndpoint = map[string]bool{
+	"bssopenapi": true,
+	"cdn":        true,
+	"dcdn":       true,
+	"scdn":       true,
+	"market":     true,
+	"dysmsapi":   true,
+}
+
+var productEndpointPrefixRegexp = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+// getEndpointType returns the endpoint type of the product, which is set by the provider product_endpoint_types
+// or endpoint_type, and defaults to public.
+func (config *Config) getEndpointType(productCode string) string {
+	if v, ok := config.ProductEndpointTypes[strings.ToLower(productCode)]; ok && v != "" {
+		return v
+	}
+	if config.EndpointType != "" {
+		return config.EndpointType
+	}
+	return EndpointTypePublic
+}
+
+// convertEndpointByType converts the public endpoint of the product to its VPC or intranet endpoint according to the
+// endpoint type. The public endpoint is used if there is no such endpoint.
+func (client *AliyunClient) convertEndpointByType(productCode, endpoint string) string {
+	endpointType := client.config.getEndpointType(productCode)
+	converted := convertEndpointType(productCode, client.config.RegionId, endpoint, endpointType)
+	if endpointType != EndpointTypePublic && converted == endpoint {
+		log.Printf("[WARN] the %s endpoint %s has no %s endpoint. Using the public endpoint instead.", productCode, endpoint, endpointType)
+	}
+	return converted
+}
+
+// convertEndpointType converts the endpoint by the rules:
+//   - OSS: oss-<region>.aliyuncs.com to oss-<region>-internal.aliyuncs.com
+//   - SLS: <region>.log.aliyuncs.com to <region>-intranet.log.aliyuncs.com
+//   - regional endpoints: <product>.<region>.aliyuncs.com to <product>-vpc.<region>.aliyuncs.com
+//   - central endpoints: <product>.aliyuncs.com to the shared service endpoint <product>.vpc-proxy.aliyuncs.com
+//
+// The RPC and ROA APIs are only served in VPC, so the intranet type of them is the same as the vpc type.
+func convertEndpointType(productCode, regionId, endpoint, endpointType string) string {
+	if endpointType != EndpointTypeVpc && endpointType != EndpointTypeIntranet {
+		return endpoint
+	}
+	if publicOnlyProductEndpoint[productCode] {
+		return endpoint
+	}
+	switch productCode {
+	case "oss":
+		if endpoint == fmt.Sprintf("oss-%s.aliyuncs.com", regionId) {
+			return fmt.Sprintf("oss-%s-internal.aliyuncs.com", regionId)
+		}
+		return endpoint
+	case "sls":
+		if endpoint == fmt.Sprintf("%s.log.aliyuncs.com", regionId) {
+			return fmt.Sprintf("%s-intranet.log.aliyuncs.com", regionId)
+		}
+		return endpoint
+	}
+	if regionId != "" && strings.HasSuffix(endpoint, fmt.Sprintf(".%s.aliyuncs.com", regionId)) {
+		prefix := strings.TrimSuffix(endpoint, fmt.Sprintf(".%s.aliyuncs.com", regionId))
+		if productEndpointPrefixRegexp.MatchString(prefix) && !strings.HasSuffix(prefix, "-vpc") {
+			return fmt.Sprintf("%s-vpc.%s.aliyuncs.com", prefix, regionId)
+		}
+		return endpoint
+	}
+	if prefix := strings.TrimSuffix(endpoint, ".aliyuncs.com"); prefix != endpoint && productEndpointPrefixRegexp.MatchString(prefix) {
+		return fmt.Sprintf("%s.vpc-proxy.aliyuncs.com", prefix)
+	}
+	return endpoint
+}
+
+// loadOtsInstanceEndpoint returns the endpoint of the Table Store instance according to the endpoint type of ots.
+func (client *AliyunClient) loadOtsInstanceEndpoint(instanceName string) string {
+	switch client.config.getEndpointType("ots") {
+	case EndpointTypeVpc:
+		return fmt.Sprintf("%s.%s.vpc.tablestore.aliyuncs.com", instanceName, client.RegionId)
+	case EndpointTypeIntranet:
+		return fmt.Sprintf("%s.%s.ots-internal.aliyuncs.com", instanceName, client.RegionId)
+	}
+	return fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
+}
+
 // Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
 func (config *Config) loadEndpointFromLocal() error {
 	data, err := ioutil.ReadFile(localEndpointPath)
@@ -645,6 +738,10 @@ func incrementalWait(firstDuration time.Duration, increaseDuration time.Duration
 	}
 }
 func (client *AliyunClient) describeEndpointForService(productCode string) (string, error) {
+	cache := getEndpointCache()
+	if endpoint := cache.get(client.config.RegionId, productCode, client.config.AccountType); endpoint != "" {
+		return endpoint, nil
+	}
 	locationCode := productCodeToLocationCode[productCode]
 	if locationCode == "" {
 		locationCode = productCode
@@ -699,6 +796,7 @@ func (client *AliyunClient) describeEndpointForService(productCode string) (stri
 	if endpointResult == "" {
 		return "", fmt.Errorf("There is no any available endpoint for %s in region %s.", productCode, client.RegionId)
 	}
+	cache.put(client.config.RegionId, productCode, client.config.AccountType, endpointResult)
 	return endpointResult, nil
 }
 
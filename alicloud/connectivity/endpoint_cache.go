@@ -0,0 +1,237 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// EndpointCacheDirEnv sets the directory of the endpoint cache file. It defaults to the Terraform plugin cache
+	// directory TF_PLUGIN_CACHE_DIR, or the user cache directory if it is not set.
+	EndpointCacheDirEnv = "ALIBABA_CLOUD_ENDPOINT_CACHE_DIR"
+	// EndpointCacheTTLEnv sets how long the cached endpoints are used, like 12h. The cache is disabled if it is 0.
+	EndpointCacheTTLEnv = "ALIBABA_CLOUD_ENDPOINT_CACHE_TTL"
+
+	endpointCacheFileName   = "alicloud-endpoints.json"
+	defaultEndpointCacheTTL = 24 * time.Hour
+)
+
+// EndpointCacheEntry is an endpoint described by the Location service.
+type EndpointCacheEntry struct {
+	Region      string    `json:"region"`
+	Product     string    `json:"product"`
+	AccountType string    `json:"account_type"`
+	Endpoint    string    `json:"endpoint"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+// endpointCache saves the endpoints described by the Location service to a file, so they are shared by the provider
+// processes launched in one Terraform run and the following runs until they are expired.
+type endpointCache struct {
+	path  string
+	ttl   time.Duration
+	mutex sync.Mutex
+}
+
+var (
+	endpointCaches       sync.Map
+	endpointCacheNowFunc = time.Now
+)
+
+// getEndpointCache returns the endpoint cache configured by the envs, or nil if it is disabled.
+func getEndpointCache() *endpointCache {
+	ttl := defaultEndpointCacheTTL
+	if v := strings.TrimSpace(os.Getenv(EndpointCacheTTLEnv)); v != "" {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			log.Printf("[WARN] parsing the env %s %s got an error: %#v. Using the default %s instead.", EndpointCacheTTLEnv, v, err, defaultEndpointCacheTTL)
+		} else {
+			ttl = duration
+		}
+	}
+	if ttl <= 0 {
+		return nil
+	}
+	dir := strings.TrimSpace(os.Getenv(EndpointCacheDirEnv))
+	if dir == "" {
+		dir = strings.TrimSpace(os.Getenv("TF_PLUGIN_CACHE_DIR"))
+	}
+	if dir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			return nil
+		}
+		dir = filepath.Join(userCacheDir, "terraform-provider-alicloud")
+	}
+	path := filepath.Join(dir, endpointCacheFileName)
+	v, _ := endpointCaches.LoadOrStore(path, &endpointCache{path: path})
+	cache := v.(*endpointCache)
+	cache.mutex.Lock()
+	cache.ttl = ttl
+	cache.mutex.Unlock()
+	return cache
+}
+
+func endpointCacheKey(region, product, accountType string) string {
+	return fmt.Sprintf("%s|%s|%s", region, strings.ToLower(product), accountType)
+}
+
+// load reads the entries which are not expired from the cache file.
+func (cache *endpointCache) load() map[string]EndpointCacheEntry {
+	entries := make(map[string]EndpointCacheEntry)
+	data, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Printf("[WARN] unmarshaling the endpoint cache %s got an error: %#v. It is ignored.", cache.path, err)
+		return make(map[string]EndpointCacheEntry)
+	}
+	now := endpointCacheNowFunc()
+	for key, entry := range entries {
+		if !entry.ExpiredAt.After(now) {
+			delete(entries, key)
+		}
+	}
+	return entries
+}
+
+// get returns the cached endpoint, or an empty string if it is not cached or expired.
+func (cache *endpointCache) get(region, product, accountType string) string {
+	if cache == nil {
+		return ""
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.load()[endpointCacheKey(region, product, accountType)].Endpoint
+}
+
+// put saves the endpoint to the cache file. The file is replaced by renaming a temporary file, so the other provider
+// processes never read a partial file.
+func (cache *endpointCache) put(region, product, accountType, endpoint string) {
+	if cache == nil || endpoint == "" {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entries := cache.load()
+	entries[endpointCacheKey(region, product, accountType)] = EndpointCacheEntry{
+		Region:      region,
+		Product:     strings.ToLower(product),
+		AccountType: accountType,
+		Endpoint:    endpoint,
+		ExpiredAt:   endpointCacheNowFunc().Add(cache.ttl),
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.Printf("[WARN] marshaling the endpoint cache got an error: %#v", err)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(cache.path), 0755); err != nil {
+		log.Printf("[WARN] creating the endpoint cache directory got an error: %#v", err)
+		return
+	}
+	file, err := ioutil.TempFile(filepath.Dir(cache.path), endpointCacheFileName+".*")
+	if err != nil {
+		log.Printf("[WARN] creating the endpoint cache file got an error: %#v", err)
+		return
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), cache.path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		log.Printf("[WARN] writing the endpoint cache %s got an error: %#v", cache.path, err)
+	}
+}
+
+// entries returns the cached entries of the region and account type sorted by product.
+func (cache *endpointCache) entries(region, accountType string) []EndpointCacheEntry {
+	if cache == nil {
+		return nil
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	var result []EndpointCacheEntry
+	for _, entry := range cache.load() {
+		if entry.Region == region && entry.AccountType == accountType {
+			result = append(result, entry)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Product < result[j].Product
+	})
+	return result
+}
+
+// PrewarmEndpointCache describes the endpoints of the products by the Location service and saves them to the endpoint
+// cache. All of the products resolved by the Location service are described if productCodes is empty.
+func (client *AliyunClient) PrewarmEndpointCache(productCodes []string) error {
+	if getEndpointCache() == nil {
+		return fmt.Errorf("the endpoint cache is disabled by the env %s", EndpointCacheTTLEnv)
+	}
+	if len(productCodes) == 0 {
+		for productCode, locationCode := range productCodeToLocationCode {
+			if _, ok := irregularProductEndpoint[productCode]; ok || locationCode == "" {
+				continue
+			}
+			productCodes = append(productCodes, productCode)
+		}
+		sort.Strings(productCodes)
+	}
+	var failed []string
+	for _, productCode := range productCodes {
+		if _, err := client.describeEndpointForService(strings.ToLower(productCode)); err != nil {
+			log.Printf("[WARN] prewarming the %s endpoint got an error: %#v", productCode, err)
+			failed = append(failed, productCode)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("describing the endpoints of the products %s failed", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// ExportEndpointCache writes the cached endpoints of the region and account type to the path in the endpoints.xml
+// format, which can be loaded by TF_ENDPOINT_PATH.
+func ExportEndpointCache(path, region, accountType string) error {
+	cache := getEndpointCache()
+	if cache == nil {
+		return fmt.Errorf("the endpoint cache is disabled by the env %s", EndpointCacheTTLEnv)
+	}
+	endpoint := Endpoint{
+		Name:      region,
+		RegionIds: RegionIds{RegionId: region},
+	}
+	for _, entry := range cache.entries(region, accountType) {
+		productName := entry.Product
+		if v, ok := productCodeToConfigEndpoints[productName]; ok {
+			productName = v
+		}
+		endpoint.Products.Product = append(endpoint.Products.Product, Product{
+			ProductName: productName,
+			DomainName:  entry.Endpoint,
+		})
+	}
+	data, err := xml.MarshalIndent(Endpoints{Endpoint: []Endpoint{endpoint}}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the endpoints got an error: %#v", err)
+	}
+	if err := ioutil.WriteFile(path, append([]byte(xml.Header), data...), 0644); err != nil {
+		return fmt.Errorf("writing the endpoints file %s got an error: %#v", path, err)
+	}
+	return nil
+}
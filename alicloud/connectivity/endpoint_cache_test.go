@@ -0,0 +1,77 @@
+package connectivity
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCommonEndpointCache(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(EndpointCacheDirEnv, dir)
+	t.Setenv(EndpointCacheTTLEnv, "1h")
+	now := time.Now()
+	endpointCacheNowFunc = func() time.Time { return now }
+	defer func() { endpointCacheNowFunc = time.Now }()
+
+	cache := getEndpointCache()
+	assert.NotNil(t, cache)
+	assert.Equal(t, filepath.Join(dir, endpointCacheFileName), cache.path)
+	assert.Empty(t, cache.get("cn-hangzhou", "ecs", "Domestic"))
+
+	cache.put("cn-hangzhou", "ecs", "Domestic", "ecs-cn-hangzhou.aliyuncs.com")
+	cache.put("cn-hangzhou", "sls", "Domestic", "cn-hangzhou.log.aliyuncs.com")
+	cache.put("cn-hangzhou", "ecs", "International", "ecs.ap-southeast-1.aliyuncs.com")
+	assert.Equal(t, "ecs-cn-hangzhou.aliyuncs.com", cache.get("cn-hangzhou", "ecs", "Domestic"))
+	assert.Equal(t, "ecs.ap-southeast-1.aliyuncs.com", cache.get("cn-hangzhou", "ecs", "International"))
+	assert.Empty(t, cache.get("cn-beijing", "ecs", "Domestic"))
+
+	// the cache file is shared by the other provider processes
+	another := &endpointCache{path: cache.path, ttl: time.Hour}
+	assert.Equal(t, "ecs-cn-hangzhou.aliyuncs.com", another.get("cn-hangzhou", "ecs", "Domestic"))
+
+	endpointCacheNowFunc = func() time.Time { return now.Add(2 * time.Hour) }
+	assert.Empty(t, cache.get("cn-hangzhou", "ecs", "Domestic"), "the expired endpoint should not be used")
+}
+
+func TestUnitCommonEndpointCacheDisabled(t *testing.T) {
+	t.Setenv(EndpointCacheDirEnv, t.TempDir())
+	t.Setenv(EndpointCacheTTLEnv, "0")
+	cache := getEndpointCache()
+	assert.Nil(t, cache)
+	cache.put("cn-hangzhou", "ecs", "Domestic", "ecs-cn-hangzhou.aliyuncs.com")
+	assert.Empty(t, cache.get("cn-hangzhou", "ecs", "Domestic"))
+	assert.Error(t, ExportEndpointCache(filepath.Join(t.TempDir(), "endpoints.xml"), "cn-hangzhou", "Domestic"))
+}
+
+func TestUnitCommonExportEndpointCache(t *testing.T) {
+	t.Setenv(EndpointCacheDirEnv, t.TempDir())
+	t.Setenv(EndpointCacheTTLEnv, "1h")
+	cache := getEndpointCache()
+	cache.put("cn-hangzhou", "ecs", "Domestic", "ecs-cn-hangzhou.aliyuncs.com")
+	cache.put("cn-hangzhou", "sls", "Domestic", "cn-hangzhou.log.aliyuncs.com")
+	cache.put("cn-beijing", "ecs", "Domestic", "ecs.cn-beijing.aliyuncs.com")
+
+	path := filepath.Join(t.TempDir(), "endpoints.xml")
+	assert.NoError(t, ExportEndpointCache(path, "cn-hangzhou", "Domestic"))
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(content), "cn-beijing")
+
+	t.Setenv(localEndpointPathEnv, path)
+	config := &Config{
+		Endpoints: new(sync.Map),
+		RegionId:  "cn-hangzhou",
+	}
+	assert.NoError(t, config.loadEndpointFromLocal())
+	val, ok := config.Endpoints.Load("ecs")
+	assert.True(t, ok)
+	assert.Equal(t, "ecs-cn-hangzhou.aliyuncs.com", val)
+	val, ok = config.Endpoints.Load("log")
+	assert.True(t, ok)
+	assert.Equal(t, "cn-hangzhou.log.aliyuncs.com", val)
+}
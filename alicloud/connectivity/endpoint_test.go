@@ -190,3 +190,84 @@ func TestUnitSlsWithoutEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitCommonConvertEndpointType(t *testing.T) {
+	testCases := []struct {
+		productCode  string
+		endpoint     string
+		endpointType string
+		expected     string
+	}{
+		{"ecs", "ecs.cn-beijing.aliyuncs.com", EndpointTypePublic, "ecs.cn-beijing.aliyuncs.com"},
+		{"ecs", "ecs.cn-beijing.aliyuncs.com", EndpointTypeVpc, "ecs-vpc.cn-beijing.aliyuncs.com"},
+		{"ecs", "ecs.cn-beijing.aliyuncs.com", EndpointTypeIntranet, "ecs-vpc.cn-beijing.aliyuncs.com"},
+		{"ecs", "ecs-vpc.cn-beijing.aliyuncs.com", EndpointTypeVpc, "ecs-vpc.cn-beijing.aliyuncs.com"},
+		{"ram", "ram.aliyuncs.com", EndpointTypeVpc, "ram.vpc-proxy.aliyuncs.com"},
+		{"oss", "oss-cn-beijing.aliyuncs.com", EndpointTypeVpc, "oss-cn-beijing-internal.aliyuncs.com"},
+		{"oss", "oss-cn-beijing.aliyuncs.com", EndpointTypeIntranet, "oss-cn-beijing-internal.aliyuncs.com"},
+		{"sls", "cn-beijing.log.aliyuncs.com", EndpointTypeIntranet, "cn-beijing-intranet.log.aliyuncs.com"},
+		{"fc_open", "cn-beijing.fc.aliyuncs.com", EndpointTypeVpc, "cn-beijing.fc.aliyuncs.com"},
+		{"waf_openapi", "wafopenapi.cn-hangzhou.aliyuncs.com", EndpointTypeVpc, "wafopenapi.cn-hangzhou.aliyuncs.com"},
+		{"bssopenapi", "business.aliyuncs.com", EndpointTypeVpc, "business.aliyuncs.com"},
+		{"ecs", "ecs.test.com", EndpointTypeVpc, "ecs.test.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.productCode+"/"+tc.endpointType, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertEndpointType(tc.productCode, "cn-beijing", tc.endpoint, tc.endpointType))
+		})
+	}
+}
+
+func TestUnitCommonLoadEndpointWithEndpointType(t *testing.T) {
+	client := &AliyunClient{
+		config: &Config{
+			Endpoints:            new(sync.Map),
+			RegionId:             "cn-hangzhou",
+			EndpointType:         EndpointTypeVpc,
+			ProductEndpointTypes: map[string]string{"cloudfw": EndpointTypePublic},
+		},
+		RegionId: "cn-hangzhou",
+	}
+
+	testCases := []struct {
+		productCode string
+		expected    string
+	}{
+		{"ram", "ram.vpc-proxy.aliyuncs.com"},
+		{"tablestore", "tablestore-vpc.cn-hangzhou.aliyuncs.com"},
+		{"cloudfw", "cloudfw.aliyuncs.com"},
+		{"bssopenapi", "business.aliyuncs.com"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.productCode, func(t *testing.T) {
+			err := client.loadEndpoint(tc.productCode)
+			assert.NoError(t, err)
+
+			val, ok := client.config.Endpoints.Load(tc.productCode)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, val)
+		})
+	}
+
+	t.Setenv("ALIBABA_CLOUD_ENDPOINT_RAM", "ram.test.com")
+	err := client.loadEndpoint("ram")
+	assert.NoError(t, err)
+	val, _ := client.config.Endpoints.Load("ram")
+	assert.Equal(t, "ram.test.com", val, "the endpoint from the environment variable should not be converted")
+}
+
+func TestUnitCommonLoadOtsInstanceEndpoint(t *testing.T) {
+	client := &AliyunClient{
+		config:   &Config{RegionId: "cn-hangzhou"},
+		RegionId: "cn-hangzhou",
+	}
+	assert.Equal(t, "tf-test.cn-hangzhou.ots.aliyuncs.com", client.loadOtsInstanceEndpoint("tf-test"))
+
+	client.config.EndpointType = EndpointTypeVpc
+	assert.Equal(t, "tf-test.cn-hangzhou.vpc.tablestore.aliyuncs.com", client.loadOtsInstanceEndpoint("tf-test"))
+
+	client.config.ProductEndpointTypes = map[string]string{"ots": EndpointTypeIntranet}
+	assert.Equal(t, "tf-test.cn-hangzhou.ots-internal.aliyuncs.com", client.loadOtsInstanceEndpoint("tf-test"))
+}
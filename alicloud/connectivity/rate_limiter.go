@@ -0,0 +1,211 @@
+package connectivity
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+)
+
+const (
+	// throttlingBaseBackoff and throttlingMaxBackoff bound the pause of a product after it responds a throttling error.
+	throttlingBaseBackoff = 500 * time.Millisecond
+	throttlingMaxBackoff  = 10 * time.Second
+	// throttlingMinRateFactor is the lowest ratio of the configured rate the adaptive rate can decrease to.
+	throttlingMinRateFactor = 0.1
+	// throttlingRecoverFactor is the ratio of the configured rate recovered by each successful request.
+	throttlingRecoverFactor = 0.05
+)
+
+// ApiRateLimit is the client side rate limit of one product API.
+// RequestsPerSecond less than or equal to 0 means the requests are not limited, and they are only paused after
+// the product responds a throttling error.
+type ApiRateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter keeps one token bucket per product code. Each bucket limits the requests to the configured rate,
+// halves the rate and pauses the product with a jittered exponential backoff once a throttling error is returned,
+// and recovers the rate gradually after the following requests succeed.
+type rateLimiter struct {
+	mutex   sync.Mutex
+	limits  map[string]ApiRateLimit
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	mutex       sync.Mutex
+	rate        float64
+	burst       float64
+	currentRate float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+	throttled   int
+	now         func() time.Time
+	random      func() float64
+}
+
+func newRateLimiter(limits map[string]ApiRateLimit) *rateLimiter {
+	normalized := make(map[string]ApiRateLimit, len(limits))
+	for productCode, limit := range limits {
+		normalized[normalizeProductCode(productCode)] = limit
+	}
+	// The limit can be set by the key of the product in the provider endpoints as well, like log for sls.
+	for productCode, configEndpoints := range productCodeToConfigEndpoints {
+		if limit, ok := normalized[configEndpoints]; ok {
+			if _, exist := normalized[productCode]; !exist {
+				normalized[productCode] = limit
+			}
+		}
+	}
+	return &rateLimiter{
+		limits:  normalized,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+func newTokenBucket(limit ApiRateLimit) *tokenBucket {
+	bucket := &tokenBucket{
+		now:    time.Now,
+		random: rand.Float64,
+	}
+	if limit.RequestsPerSecond > 0 {
+		bucket.rate = limit.RequestsPerSecond
+		bucket.burst = float64(limit.Burst)
+		if bucket.burst < 1 {
+			bucket.burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+		}
+		bucket.currentRate = bucket.rate
+		bucket.tokens = bucket.burst
+	}
+	return bucket
+}
+
+func normalizeProductCode(productCode string) string {
+	return strings.ToLower(ConvertKebabToSnake(productCode))
+}
+
+func (limiter *rateLimiter) bucket(productCode string) *tokenBucket {
+	productCode = normalizeProductCode(productCode)
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	bucket, ok := limiter.buckets[productCode]
+	if !ok {
+		bucket = newTokenBucket(limiter.limits[productCode])
+		limiter.buckets[productCode] = bucket
+	}
+	return bucket
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to wait before sending the request.
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	now := bucket.now()
+	var wait time.Duration
+	if bucket.pausedUntil.After(now) {
+		wait = bucket.pausedUntil.Sub(now)
+	}
+	if bucket.currentRate <= 0 {
+		return wait
+	}
+	if !bucket.last.IsZero() {
+		bucket.tokens = math.Min(bucket.burst, bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.currentRate)
+	}
+	bucket.last = now
+	bucket.tokens--
+	if bucket.tokens < 0 {
+		if tokenWait := time.Duration(-bucket.tokens / bucket.currentRate * float64(time.Second)); tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+	return wait
+}
+
+// onThrottled pauses the product with a jittered exponential backoff and halves its adaptive rate.
+func (bucket *tokenBucket) onThrottled() time.Duration {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.throttled++
+	backoff := throttlingBaseBackoff << uint(bucket.throttled-1)
+	if backoff <= 0 || backoff > throttlingMaxBackoff {
+		backoff = throttlingMaxBackoff
+	}
+	// Equal jitter keeps at least half of the backoff, and spreads the parallel requests over the other half.
+	backoff = backoff/2 + time.Duration(bucket.random()*float64(backoff/2))
+	if pausedUntil := bucket.now().Add(backoff); pausedUntil.After(bucket.pausedUntil) {
+		bucket.pausedUntil = pausedUntil
+	}
+	if bucket.rate > 0 {
+		bucket.currentRate = math.Max(bucket.currentRate/2, bucket.rate*throttlingMinRateFactor)
+	}
+	return backoff
+}
+
+// onSuccess resets the backoff and recovers the adaptive rate towards the configured one.
+func (bucket *tokenBucket) onSuccess() {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+	bucket.throttled = 0
+	if bucket.rate > 0 && bucket.currentRate < bucket.rate {
+		bucket.currentRate = math.Min(bucket.rate, bucket.currentRate+bucket.rate*throttlingRecoverFactor)
+	}
+}
+
+// Wait blocks until the product is allowed to send a request.
+func (limiter *rateLimiter) Wait(productCode string) {
+	if wait := limiter.bucket(productCode).reserve(); wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+// Observe adapts the rate of the product according to the result of its request.
+func (limiter *rateLimiter) Observe(productCode string, err error) {
+	bucket := limiter.bucket(productCode)
+	if IsThrottlingError(err) {
+		bucket.onThrottled()
+		return
+	}
+	bucket.onSuccess()
+}
+
+// IsThrottlingError returns whether the error is a throttling error responded by the product.
+func IsThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var code string
+	switch e := err.(type) {
+	case *tea.SDKError:
+		code = tea.StringValue(e.Code)
+	case *errors.ServerError:
+		code = e.ErrorCode()
+	case oss.ServiceError:
+		code = e.Code
+	case *oss.ServiceError:
+		code = e.Code
+	default:
+		code = err.Error()
+	}
+	return strings.Contains(code, "Throttling")
+}
+
+func (client *AliyunClient) getRateLimiter() *rateLimiter {
+	client.rateLimiterOnce.Do(func() {
+		if client.rateLimiter == nil {
+			var limits map[string]ApiRateLimit
+			if client.config != nil {
+				limits = client.config.ApiRateLimits
+			}
+			client.rateLimiter = newRateLimiter(limits)
+		}
+	})
+	return client.rateLimiter
+}
@@ -0,0 +1,131 @@
+package connectivity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func newTestTokenBucket(limit ApiRateLimit, clock *fakeClock) *tokenBucket {
+	bucket := newTokenBucket(limit)
+	bucket.now = clock.Now
+	bucket.random = func() float64 { return 0.5 }
+	return bucket
+}
+
+func TestUnitCommonTokenBucketReserve(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	bucket := newTestTokenBucket(ApiRateLimit{RequestsPerSecond: 2, Burst: 2}, clock)
+
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	assert.Equal(t, 500*time.Millisecond, bucket.reserve(), "the third request should wait for a new token")
+	assert.Equal(t, time.Second, bucket.reserve(), "the reserved tokens should be queued")
+
+	clock.now = clock.now.Add(2 * time.Second)
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+}
+
+func TestUnitCommonTokenBucketDefaultBurst(t *testing.T) {
+	bucket := newTokenBucket(ApiRateLimit{RequestsPerSecond: 2.5})
+	assert.Equal(t, float64(3), bucket.burst)
+
+	bucket = newTokenBucket(ApiRateLimit{RequestsPerSecond: 0.2})
+	assert.Equal(t, float64(1), bucket.burst)
+
+	unlimited := newTokenBucket(ApiRateLimit{})
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Duration(0), unlimited.reserve())
+	}
+}
+
+func TestUnitCommonTokenBucketThrottled(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	bucket := newTestTokenBucket(ApiRateLimit{RequestsPerSecond: 10, Burst: 10}, clock)
+
+	// equal jitter with a random value 0.5 returns 3/4 of the backoff
+	assert.Equal(t, 375*time.Millisecond, bucket.onThrottled())
+	assert.Equal(t, float64(5), bucket.currentRate)
+	assert.Equal(t, 375*time.Millisecond, bucket.reserve(), "the product should be paused after throttled")
+
+	assert.Equal(t, 750*time.Millisecond, bucket.onThrottled())
+	assert.Equal(t, 2.5, bucket.currentRate)
+	for i := 0; i < 10; i++ {
+		bucket.onThrottled()
+	}
+	assert.Equal(t, float64(1), bucket.currentRate, "the adaptive rate should not be less than the min rate")
+	assert.Equal(t, throttlingMaxBackoff*3/4, bucket.onThrottled(), "the backoff should be bounded")
+
+	bucket.onSuccess()
+	assert.Equal(t, 0, bucket.throttled)
+	assert.Equal(t, 1.5, bucket.currentRate)
+	for i := 0; i < 100; i++ {
+		bucket.onSuccess()
+	}
+	assert.Equal(t, float64(10), bucket.currentRate, "the adaptive rate should recover to the configured rate")
+}
+
+func TestUnitCommonTokenBucketThrottledWithoutLimit(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	bucket := newTestTokenBucket(ApiRateLimit{}, clock)
+
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+	bucket.onThrottled()
+	assert.Equal(t, float64(0), bucket.currentRate)
+	assert.Equal(t, 375*time.Millisecond, bucket.reserve())
+
+	clock.now = clock.now.Add(time.Second)
+	assert.Equal(t, time.Duration(0), bucket.reserve())
+}
+
+func TestUnitCommonRateLimiterProductCode(t *testing.T) {
+	limiter := newRateLimiter(map[string]ApiRateLimit{
+		"ECS": {RequestsPerSecond: 5},
+		"log": {RequestsPerSecond: 2},
+	})
+	assert.Equal(t, float64(5), limiter.bucket("Ecs").rate)
+	assert.True(t, limiter.bucket("ecs") == limiter.bucket("Ecs"))
+	assert.Equal(t, float64(2), limiter.bucket("sls").rate)
+	assert.Equal(t, float64(2), limiter.bucket("Sls").rate)
+	assert.Equal(t, float64(0), limiter.bucket("vpc").rate)
+}
+
+func TestUnitCommonIsThrottlingError(t *testing.T) {
+	assert.False(t, IsThrottlingError(nil))
+	assert.True(t, IsThrottlingError(&tea.SDKError{Code: tea.String("Throttling.User"), Message: tea.String("Request was denied due to user flow control.")}))
+	assert.True(t, IsThrottlingError(&tea.SDKError{Code: tea.String("Rejected.Throttling"), Message: tea.String("")}))
+	assert.False(t, IsThrottlingError(&tea.SDKError{Code: tea.String("InvalidParameter"), Message: tea.String("Throttling")}))
+	assert.True(t, IsThrottlingError(errors.NewServerError(400, `{"Code":"Throttling","Message":"Request was denied due to request throttling."}`, "")))
+	assert.True(t, IsThrottlingError(fmt.Errorf("[ERROR] Throttling.Api")))
+	assert.False(t, IsThrottlingError(fmt.Errorf("InvalidVpcId.NotFound")))
+}
+
+func TestUnitCommonInvokeWithRateLimit(t *testing.T) {
+	client := &AliyunClient{config: &Config{}}
+	_, err := client.invoke("vpc", func() (interface{}, error) {
+		return nil, &tea.SDKError{Code: tea.String("Throttling"), Message: tea.String("")}
+	})
+	assert.NotNil(t, err)
+	bucket := client.getRateLimiter().bucket("vpc")
+	assert.Equal(t, 1, bucket.throttled)
+	assert.True(t, bucket.pausedUntil.After(time.Now()))
+
+	raw, err := client.invoke("vpc", func() (interface{}, error) {
+		return "ok", nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", raw)
+	assert.Equal(t, 0, bucket.throttled)
+}
@@ -0,0 +1,398 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RecorderModeEnv selects the mode of the recorder, record or replay. The recorder is disabled if it is empty.
+	RecorderModeEnv = "ALICLOUD_RECORDER_MODE"
+	// RecorderCassetteDirEnv sets the directory of the cassette files, and it defaults to testdata/cassettes.
+	RecorderCassetteDirEnv = "ALICLOUD_CASSETTE_DIR"
+
+	RecorderModeRecord = "record"
+	RecorderModeReplay = "replay"
+
+	defaultCassetteDir = "testdata/cassettes"
+)
+
+// The request parameters which are different in every request, like the signature, nonce and timestamp. They are
+// removed from the recorded requests, so the replayed requests can match them and no credential is written to the
+// cassette files.
+var cassetteVolatileParameters = []string{
+	"Signature",
+	"SignatureNonce",
+	"SignatureMethod",
+	"SignatureVersion",
+	"SignatureType",
+	"Timestamp",
+	"AccessKeyId",
+	"SecurityToken",
+	"BearerToken",
+	"ClientToken",
+}
+
+// The response headers which are not written to the cassette files.
+var cassetteIgnoredResponseHeaders = []string{
+	"Connection",
+	"Content-Length",
+	"Date",
+	"Keep-Alive",
+	"Set-Cookie",
+	"Transfer-Encoding",
+}
+
+// Cassette is the content of a cassette file, which saves the API requests and responses of one recorded session.
+type Cassette struct {
+	// Seed is the random seed of the recorded session, which is used to generate the same resource names in the replay.
+	Seed         int64                  `json:"seed"`
+	Interactions []*CassetteInteraction `json:"interactions"`
+}
+
+// CassetteInteraction is a pair of the API request and response.
+type CassetteInteraction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+// CassetteRequest is an API request without the volatile parameters.
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Host   string `json:"host"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// CassetteResponse is an API response.
+type CassetteResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// Recorder is an HTTP proxy in the process, which the RPC and ROA API requests are sent to while it is started.
+// In the record mode, it forwards the requests to the real endpoints over HTTPS and saves the request and response
+// pairs to a cassette file when it is stopped. In the replay mode, it serves the responses from the cassette file in
+// the recorded order, so the acceptance tests can run without a live account.
+type Recorder struct {
+	mode     string
+	path     string
+	mutex    sync.Mutex
+	cassette *Cassette
+	replayed []bool
+	listener net.Listener
+	server   *http.Server
+	upstream http.RoundTripper
+}
+
+var (
+	activeRecorderMutex sync.RWMutex
+	activeRecorder      *Recorder
+)
+
+// StartRecorder starts the recorder with the cassette named name in the mode of the env ALICLOUD_RECORDER_MODE.
+// It returns nil if the env is not set. Only one recorder can be started at the same time.
+func StartRecorder(name string) (*Recorder, error) {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv(RecorderModeEnv)))
+	if mode == "" {
+		return nil, nil
+	}
+	if mode != RecorderModeRecord && mode != RecorderModeReplay {
+		return nil, fmt.Errorf("the env %s should be %s or %s, got %s", RecorderModeEnv, RecorderModeRecord, RecorderModeReplay, mode)
+	}
+	dir := os.Getenv(RecorderCassetteDirEnv)
+	if dir == "" {
+		dir = defaultCassetteDir
+	}
+	recorder := &Recorder{
+		mode: mode,
+		path: filepath.Join(dir, cassetteFileName(name)),
+		upstream: &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSHandshakeTimeout: 120 * time.Second,
+		},
+	}
+	if mode == RecorderModeReplay {
+		cassette, err := loadCassette(recorder.path)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = cassette
+		recorder.replayed = make([]bool, len(cassette.Interactions))
+	} else {
+		recorder.cassette = &Cassette{Seed: time.Now().UnixNano()}
+	}
+
+	activeRecorderMutex.Lock()
+	defer activeRecorderMutex.Unlock()
+	if activeRecorder != nil {
+		return nil, fmt.Errorf("the recorder with the cassette %s has been started", activeRecorder.path)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("starting the recorder got an error: %#v", err)
+	}
+	recorder.listener = listener
+	recorder.server = &http.Server{Handler: recorder}
+	go recorder.server.Serve(listener)
+	activeRecorder = recorder
+	log.Printf("[INFO] The recorder is started in the %s mode with the cassette %s", mode, recorder.path)
+	return recorder, nil
+}
+
+// Stop stops the recorder, and writes the cassette file in the record mode.
+func (recorder *Recorder) Stop() error {
+	if recorder == nil {
+		return nil
+	}
+	activeRecorderMutex.Lock()
+	if activeRecorder == recorder {
+		activeRecorder = nil
+	}
+	activeRecorderMutex.Unlock()
+	recorder.server.Close()
+	if recorder.mode != RecorderModeRecord {
+		return nil
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	content, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the cassette %s got an error: %#v", recorder.path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(recorder.path), 0755); err != nil {
+		return fmt.Errorf("creating the cassette directory got an error: %#v", err)
+	}
+	if err := ioutil.WriteFile(recorder.path, content, 0644); err != nil {
+		return fmt.Errorf("writing the cassette %s got an error: %#v", recorder.path, err)
+	}
+	return nil
+}
+
+// Mode returns the mode of the recorder, or an empty string if it is nil.
+func (recorder *Recorder) Mode() string {
+	if recorder == nil {
+		return ""
+	}
+	return recorder.mode
+}
+
+// Rand returns a random generator seeded by the cassette, which generates the same numbers in the record and replay.
+func (recorder *Recorder) Rand() *rand.Rand {
+	if recorder == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return rand.New(rand.NewSource(recorder.cassette.Seed))
+}
+
+// ProxyURL returns the URL of the recorder used as the HTTP proxy.
+func (recorder *Recorder) ProxyURL() *url.URL {
+	if recorder == nil {
+		return nil
+	}
+	return &url.URL{Scheme: "http", Host: recorder.listener.Addr().String()}
+}
+
+// getRecorderProxy returns the proxy URL of the started recorder, or nil if there is no started recorder.
+func getRecorderProxy() *url.URL {
+	activeRecorderMutex.RLock()
+	defer activeRecorderMutex.RUnlock()
+	return activeRecorder.ProxyURL()
+}
+
+func (recorder *Recorder) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	body, err := ioutil.ReadAll(request.Body)
+	if err != nil {
+		writeRecorderError(writer, http.StatusBadGateway, "RecorderReadRequestFailed", err.Error())
+		return
+	}
+	cassetteRequest := normalizeCassetteRequest(request, body)
+
+	var response *CassetteResponse
+	if recorder.mode == RecorderModeReplay {
+		response = recorder.replay(cassetteRequest)
+		if response == nil {
+			log.Printf("[ERROR] The recorder can not find the interaction of the request %s %s%s?%s in the cassette %s", cassetteRequest.Method, cassetteRequest.Host, cassetteRequest.Path, cassetteRequest.Query, recorder.path)
+			writeRecorderError(writer, http.StatusNotImplemented, "RecorderInteractionNotFound", fmt.Sprintf("the request %s %s%s is not found in the cassette %s", cassetteRequest.Method, cassetteRequest.Host, cassetteRequest.Path, recorder.path))
+			return
+		}
+	} else {
+		response, err = recorder.record(request, body, cassetteRequest)
+		if err != nil {
+			writeRecorderError(writer, http.StatusBadGateway, "RecorderForwardRequestFailed", err.Error())
+			return
+		}
+	}
+	for key, value := range response.Headers {
+		writer.Header().Set(key, value)
+	}
+	writer.WriteHeader(response.StatusCode)
+	writer.Write([]byte(response.Body))
+}
+
+// record forwards the request to the real endpoint over HTTPS and saves the interaction.
+func (recorder *Recorder) record(request *http.Request, body []byte, cassetteRequest CassetteRequest) (*CassetteResponse, error) {
+	upstreamRequest := request.Clone(request.Context())
+	upstreamRequest.RequestURI = ""
+	upstreamRequest.URL.Scheme = "https"
+	upstreamRequest.URL.Host = cassetteRequest.Host
+	upstreamRequest.Host = cassetteRequest.Host
+	upstreamRequest.Body = ioutil.NopCloser(bytes.NewReader(body))
+	upstreamRequest.ContentLength = int64(len(body))
+	// The response is decompressed by the transport, so the cassette files are readable.
+	upstreamRequest.Header.Del("Accept-Encoding")
+	upstreamRequest.Header.Del("Proxy-Connection")
+
+	upstreamResponse, err := recorder.upstream.RoundTrip(upstreamRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer upstreamResponse.Body.Close()
+	responseBody, err := ioutil.ReadAll(upstreamResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	response := CassetteResponse{
+		StatusCode: upstreamResponse.StatusCode,
+		Headers:    map[string]string{},
+		Body:       string(responseBody),
+	}
+	for key := range upstreamResponse.Header {
+		if !cassetteHeaderIgnored(key) {
+			response.Headers[key] = upstreamResponse.Header.Get(key)
+		}
+	}
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, &CassetteInteraction{
+		Request:  cassetteRequest,
+		Response: response,
+	})
+	return &response, nil
+}
+
+// replay returns the response of the first interaction not replayed yet which has the same request. If there is not
+// one, it falls back to the first interaction which invokes the same API, because some parameters, like the time in
+// the filters, are generated when the tests run.
+func (recorder *Recorder) replay(cassetteRequest CassetteRequest) *CassetteResponse {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	index := -1
+	for i, interaction := range recorder.cassette.Interactions {
+		if !recorder.replayed[i] && interaction.Request == cassetteRequest {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		for i, interaction := range recorder.cassette.Interactions {
+			if !recorder.replayed[i] && sameCassetteApi(interaction.Request, cassetteRequest) {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		return nil
+	}
+	recorder.replayed[index] = true
+	response := recorder.cassette.Interactions[index].Response
+	return &response
+}
+
+func normalizeCassetteRequest(request *http.Request, body []byte) CassetteRequest {
+	host := request.URL.Host
+	if host == "" {
+		host = request.Host
+	}
+	cassetteRequest := CassetteRequest{
+		Method: request.Method,
+		Host:   host,
+		Path:   request.URL.Path,
+		Query:  normalizeCassetteValues(request.URL.Query()),
+		Body:   string(body),
+	}
+	if strings.Contains(request.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			cassetteRequest.Body = normalizeCassetteValues(values)
+		}
+	}
+	return cassetteRequest
+}
+
+// normalizeCassetteValues removes the volatile parameters and encodes the values sorted by key.
+func normalizeCassetteValues(values url.Values) string {
+	for _, key := range cassetteVolatileParameters {
+		values.Del(key)
+	}
+	return values.Encode()
+}
+
+func sameCassetteApi(recorded, request CassetteRequest) bool {
+	if recorded.Method != request.Method || recorded.Host != request.Host || recorded.Path != request.Path {
+		return false
+	}
+	return cassetteApiAction(recorded) == cassetteApiAction(request)
+}
+
+// cassetteApiAction returns the action of the RPC request, which is in the query or form body.
+func cassetteApiAction(request CassetteRequest) string {
+	for _, encoded := range []string{request.Query, request.Body} {
+		if values, err := url.ParseQuery(encoded); err == nil && values.Get("Action") != "" {
+			return values.Get("Action")
+		}
+	}
+	return ""
+}
+
+func cassetteHeaderIgnored(key string) bool {
+	for _, ignored := range cassetteIgnoredResponseHeaders {
+		if strings.EqualFold(key, ignored) {
+			return true
+		}
+	}
+	return false
+}
+
+var cassetteFileNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+
+func cassetteFileName(name string) string {
+	return cassetteFileNameRegexp.ReplaceAllString(name, "_") + ".json"
+}
+
+func loadCassette(path string) (*Cassette, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading the cassette %s got an error: %#v", path, err)
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(content, cassette); err != nil {
+		return nil, fmt.Errorf("unmarshaling the cassette %s got an error: %#v", path, err)
+	}
+	return cassette, nil
+}
+
+func writeRecorderError(writer http.ResponseWriter, statusCode int, code, message string) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	json.NewEncoder(writer).Encode(map[string]string{
+		"Code":    code,
+		"Message": message,
+	})
+}
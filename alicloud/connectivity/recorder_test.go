@@ -0,0 +1,121 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recorderRoundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f recorderRoundTripFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestUnitCommonRecorderRecordAndReplay(t *testing.T) {
+	var upstreamRequests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		action := r.URL.Query().Get("Action")
+		fmt.Fprintf(w, `{"RequestId":"REQUEST-ID-%d","Action":"%s"}`, len(upstreamRequests), action)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	t.Setenv(RecorderCassetteDirEnv, dir)
+	t.Setenv(RecorderModeEnv, RecorderModeRecord)
+	recorder, err := StartRecorder("TestAccAliCloudVpc/basic")
+	assert.Nil(t, err)
+	recorder.upstream = recorderRoundTripFunc(func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, "https", request.URL.Scheme)
+		assert.Equal(t, "vpc.aliyuncs.com", request.URL.Host)
+		upstreamRequests = append(upstreamRequests, request)
+		request.URL.Scheme = "http"
+		request.URL.Host = strings.TrimPrefix(server.URL, "http://")
+		return http.DefaultTransport.RoundTrip(request)
+	})
+	seed := recorder.Rand().Int()
+
+	client, fakeServer := newTeaPoolTestClient(t, &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"})
+	fakeServer.Close()
+	client.config.Endpoints.Store("vpc", "vpc.aliyuncs.com")
+	response, err := client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", map[string]interface{}{"RegionId": "cn-hangzhou"}, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "DescribeVpcs", response["Action"])
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", map[string]interface{}{"RegionId": "cn-hangzhou"}, nil, false)
+	assert.Nil(t, err)
+	assert.Len(t, upstreamRequests, 2)
+	assert.Nil(t, recorder.Stop())
+	assert.Nil(t, getRecorderProxy())
+
+	content, err := os.ReadFile(filepath.Join(dir, "TestAccAliCloudVpc_basic.json"))
+	assert.Nil(t, err)
+	assert.Contains(t, string(content), "DescribeVpcs")
+	assert.NotContains(t, string(content), "test-ak")
+	assert.NotContains(t, string(content), "SignatureNonce")
+
+	t.Setenv(RecorderModeEnv, RecorderModeReplay)
+	recorder, err = StartRecorder("TestAccAliCloudVpc/basic")
+	assert.Nil(t, err)
+	defer recorder.Stop()
+	recorder.upstream = recorderRoundTripFunc(func(request *http.Request) (*http.Response, error) {
+		t.Errorf("the replay should not send the request %s", request.URL)
+		return nil, fmt.Errorf("unexpected request %s", request.URL)
+	})
+	assert.Equal(t, seed, recorder.Rand().Int(), "the replay should have the same random seed")
+
+	client, fakeServer = newTeaPoolTestClient(t, &mockCredential{accessKeyId: "other-ak", accessKeySecret: "other-sk"})
+	fakeServer.Close()
+	client.config.Endpoints.Store("vpc", "vpc.aliyuncs.com")
+	response, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", map[string]interface{}{"RegionId": "cn-hangzhou"}, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "REQUEST-ID-1", response["RequestId"])
+	response, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", map[string]interface{}{"RegionId": "cn-hangzhou"}, nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, "REQUEST-ID-2", response["RequestId"])
+	_, err = client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", map[string]interface{}{"RegionId": "cn-hangzhou"}, nil, false)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "RecorderInteractionNotFound")
+}
+
+func TestUnitCommonRecorderDisabled(t *testing.T) {
+	t.Setenv(RecorderModeEnv, "")
+	recorder, err := StartRecorder("TestAccAliCloudVpc_basic")
+	assert.Nil(t, err)
+	assert.Nil(t, recorder)
+	assert.Nil(t, recorder.Stop())
+	assert.Nil(t, getRecorderProxy())
+
+	t.Setenv(RecorderModeEnv, "playback")
+	_, err = StartRecorder("TestAccAliCloudVpc_basic")
+	assert.NotNil(t, err)
+
+	t.Setenv(RecorderModeEnv, RecorderModeReplay)
+	t.Setenv(RecorderCassetteDirEnv, t.TempDir())
+	_, err = StartRecorder("TestAccAliCloudVpc_basic")
+	assert.NotNil(t, err, "the replay should fail without the cassette")
+}
+
+func TestUnitCommonNormalizeCassetteRequest(t *testing.T) {
+	request := httptest.NewRequest("POST", "http://ecs.aliyuncs.com/?Action=RunInstances&Timestamp=2026-10-18T00%3A00%3A00Z&SignatureNonce=abc&Signature=xyz&AccessKeyId=test-ak&RegionId=cn-hangzhou", nil)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body := url.Values{"InstanceName": {"tf-test"}, "ClientToken": {"token"}, "SecurityToken": {"secret"}}.Encode()
+
+	cassetteRequest := normalizeCassetteRequest(request, []byte(body))
+	assert.Equal(t, CassetteRequest{
+		Method: "POST",
+		Host:   "ecs.aliyuncs.com",
+		Path:   "/",
+		Query:  "Action=RunInstances&RegionId=cn-hangzhou",
+		Body:   "InstanceName=tf-test",
+	}, cassetteRequest)
+	assert.Equal(t, "RunInstances", cassetteApiAction(cassetteRequest))
+	assert.Equal(t, "TestAccAliCloudVpc_basic.json", cassetteFileName("TestAccAliCloudVpc/basic"))
+}
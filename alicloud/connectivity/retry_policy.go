@@ -0,0 +1,201 @@
+package connectivity
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+const (
+	DefaultRetryMaxElapsedTime  = 5 * time.Minute
+	DefaultRetryInitialInterval = time.Second
+	DefaultRetryMaxInterval     = 30 * time.Second
+	DefaultRetryMultiplier      = 2.0
+	DefaultRetryJitter          = 0.5
+)
+
+// RetryClassifier reports whether the error returned by a request is retryable.
+type RetryClassifier func(err error) bool
+
+// RetryPolicy describes how a request is retried. The request is retried with an exponential backoff and jitter
+// while one of its classifiers reports the error is retryable, until the attempts or the elapsed time run out.
+//
+// A zero MaxAttempts means the attempts are only bounded by MaxElapsedTime, and a zero MaxElapsedTime means
+// the elapsed time is only bounded by MaxAttempts.
+type RetryPolicy struct {
+	MaxAttempts     int
+	MaxElapsedTime  time.Duration
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	// Jitter is the ratio of the backoff which is randomized, its value is in the range [0, 1].
+	Jitter      float64
+	Classifiers []RetryClassifier
+
+	sleep  func(time.Duration)
+	now    func() time.Time
+	random func() float64
+}
+
+// NewRetryPolicy returns a retry policy with the default backoff which retries the errors matched by the classifiers.
+// The NeedRetry classifier is used if there is no classifier.
+func NewRetryPolicy(classifiers ...RetryClassifier) *RetryPolicy {
+	if len(classifiers) == 0 {
+		classifiers = []RetryClassifier{RetryOnNeedRetry}
+	}
+	return &RetryPolicy{
+		MaxElapsedTime:  DefaultRetryMaxElapsedTime,
+		InitialInterval: DefaultRetryInitialInterval,
+		MaxInterval:     DefaultRetryMaxInterval,
+		Multiplier:      DefaultRetryMultiplier,
+		Jitter:          DefaultRetryJitter,
+		Classifiers:     classifiers,
+	}
+}
+
+// NewRetryPolicy returns a retry policy whose max elapsed time is the provider max_retry_timeout if it is set,
+// otherwise the defaultTimeout.
+func (client *AliyunClient) NewRetryPolicy(defaultTimeout time.Duration, classifiers ...RetryClassifier) *RetryPolicy {
+	policy := NewRetryPolicy(classifiers...)
+	policy.MaxElapsedTime = client.GetRetryTimeout(defaultTimeout)
+	return policy
+}
+
+// RetryOnNeedRetry retries the network errors, the 5xx errors and the throttling errors.
+func RetryOnNeedRetry(err error) bool {
+	return err != nil && needRetry(err)
+}
+
+// RetryOnThrottling retries the throttling errors.
+func RetryOnThrottling(err error) bool {
+	return IsThrottlingError(err)
+}
+
+// RetryOnErrorCodes retries the errors with one of the expected codes.
+func RetryOnErrorCodes(codes ...string) RetryClassifier {
+	return func(err error) bool {
+		return isExpectedErrors(err, codes)
+	}
+}
+
+// Retryable returns whether the error is retryable by one of the classifiers.
+func (policy *RetryPolicy) Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, classifier := range policy.Classifiers {
+		if classifier != nil && classifier(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the wait time before the next attempt after the attempt failed.
+func (policy *RetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	interval := policy.InitialInterval
+	if interval <= 0 {
+		interval = DefaultRetryInitialInterval
+	}
+	multiplier := policy.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultRetryMultiplier
+	}
+	backoff := float64(interval) * math.Pow(multiplier, float64(attempt-1))
+	if policy.MaxInterval > 0 && backoff > float64(policy.MaxInterval) {
+		backoff = float64(policy.MaxInterval)
+	}
+	jitter := math.Min(math.Max(policy.Jitter, 0), 1)
+	random := rand.Float64
+	if policy.random != nil {
+		random = policy.random
+	}
+	return time.Duration(backoff * (1 - jitter*random()))
+}
+
+// Run invokes the do until it succeeds, or its error is not retryable, or the policy runs out.
+func (policy *RetryPolicy) Run(do func() error) error {
+	sleep, now := time.Sleep, time.Now
+	if policy.sleep != nil {
+		sleep = policy.sleep
+	}
+	if policy.now != nil {
+		now = policy.now
+	}
+	start := now()
+	for attempt := 1; ; attempt++ {
+		err := do()
+		if err == nil || !policy.Retryable(err) {
+			return err
+		}
+		if policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts {
+			return err
+		}
+		backoff := policy.Backoff(attempt)
+		if policy.MaxElapsedTime > 0 && now().Sub(start)+backoff > policy.MaxElapsedTime {
+			return err
+		}
+		sleep(backoff)
+	}
+}
+
+// merge returns a copy of the policy whose settings are overridden by the non-zero settings of the override.
+// The classifiers are kept unless the policy has none.
+func (policy RetryPolicy) merge(override RetryPolicy) *RetryPolicy {
+	if override.MaxAttempts > 0 {
+		policy.MaxAttempts = override.MaxAttempts
+	}
+	if override.MaxElapsedTime > 0 {
+		policy.MaxElapsedTime = override.MaxElapsedTime
+	}
+	if override.InitialInterval > 0 {
+		policy.InitialInterval = override.InitialInterval
+	}
+	if override.MaxInterval > 0 {
+		policy.MaxInterval = override.MaxInterval
+	}
+	if override.Multiplier >= 1 {
+		policy.Multiplier = override.Multiplier
+	}
+	if override.Jitter > 0 {
+		policy.Jitter = override.Jitter
+	}
+	if len(policy.Classifiers) == 0 {
+		policy.Classifiers = override.Classifiers
+	}
+	return &policy
+}
+
+// getRetryPolicy returns the retry policy of the product request. The policy passed by the request is overridden
+// by the provider api_retry_policies of the product, and the request without a policy is retried with
+// the default policy only when the provider sets the policy of the product.
+func (client *AliyunClient) getRetryPolicy(productCode string, retryPolicy ...*RetryPolicy) *RetryPolicy {
+	var policy *RetryPolicy
+	for _, p := range retryPolicy {
+		if p != nil {
+			policy = p
+			break
+		}
+	}
+	var override RetryPolicy
+	var overridden bool
+	if client.config != nil && len(client.config.RetryPolicies) > 0 {
+		productCode = normalizeProductCode(productCode)
+		override, overridden = client.config.RetryPolicies[productCode]
+		if !overridden {
+			if configEndpoints, ok := productCodeToConfigEndpoints[productCode]; ok {
+				override, overridden = client.config.RetryPolicies[configEndpoints]
+			}
+		}
+	}
+	if !overridden {
+		return policy
+	}
+	if policy == nil {
+		policy = client.NewRetryPolicy(DefaultRetryMaxElapsedTime)
+	}
+	return policy.merge(override)
+}
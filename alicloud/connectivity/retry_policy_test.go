@@ -0,0 +1,165 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRetryPolicy(clock *fakeClock, classifiers ...RetryClassifier) (*RetryPolicy, *[]time.Duration) {
+	var sleeps []time.Duration
+	policy := NewRetryPolicy(classifiers...)
+	policy.now = clock.Now
+	policy.random = func() float64 { return 0 }
+	policy.sleep = func(d time.Duration) {
+		sleeps = append(sleeps, d)
+		clock.now = clock.now.Add(d)
+	}
+	return policy, &sleeps
+}
+
+func TestUnitCommonRetryPolicyBackoff(t *testing.T) {
+	policy := NewRetryPolicy()
+	policy.random = func() float64 { return 0 }
+	assert.Equal(t, time.Second, policy.Backoff(1))
+	assert.Equal(t, 2*time.Second, policy.Backoff(2))
+	assert.Equal(t, 16*time.Second, policy.Backoff(5))
+	assert.Equal(t, DefaultRetryMaxInterval, policy.Backoff(10), "the backoff should be bounded by the max interval")
+
+	policy.random = func() float64 { return 1 }
+	assert.Equal(t, 500*time.Millisecond, policy.Backoff(1), "the jitter should randomize half of the backoff")
+	policy.Jitter = 0
+	assert.Equal(t, time.Second, policy.Backoff(1))
+}
+
+func TestUnitCommonRetryPolicyRun(t *testing.T) {
+	throttling := &tea.SDKError{Code: tea.String("Throttling"), Message: tea.String("Request was denied due to request throttling.")}
+	notFound := &tea.SDKError{Code: tea.String("InvalidVpcId.NotFound"), Message: tea.String(""), Data: tea.String("")}
+
+	clock := &fakeClock{now: time.Now()}
+	policy, sleeps := newTestRetryPolicy(clock)
+	attempts := 0
+	err := policy.Run(func() error {
+		attempts++
+		if attempts < 3 {
+			return throttling
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *sleeps)
+
+	attempts = 0
+	err = policy.Run(func() error {
+		attempts++
+		return notFound
+	})
+	assert.Equal(t, notFound, err)
+	assert.Equal(t, 1, attempts, "the error which is not retryable should be returned at once")
+
+	policy, _ = newTestRetryPolicy(clock)
+	policy.MaxAttempts = 4
+	attempts = 0
+	err = policy.Run(func() error {
+		attempts++
+		return throttling
+	})
+	assert.Equal(t, throttling, err)
+	assert.Equal(t, 4, attempts)
+
+	policy, sleeps = newTestRetryPolicy(clock)
+	policy.MaxElapsedTime = 10 * time.Second
+	attempts = 0
+	err = policy.Run(func() error {
+		attempts++
+		return throttling
+	})
+	assert.Equal(t, throttling, err)
+	assert.Equal(t, 4, attempts, "the retry should stop before the elapsed time runs out")
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}, *sleeps)
+}
+
+func TestUnitCommonRetryPolicyClassifiers(t *testing.T) {
+	incorrectStatus := &tea.SDKError{Code: tea.String("IncorrectStatus"), Message: tea.String(""), Data: tea.String("")}
+	throttling := &tea.SDKError{Code: tea.String("Throttling.User"), Message: tea.String(""), Data: tea.String("")}
+
+	policy := NewRetryPolicy()
+	assert.False(t, policy.Retryable(nil))
+	assert.True(t, policy.Retryable(throttling))
+	assert.False(t, policy.Retryable(incorrectStatus))
+
+	policy = NewRetryPolicy(RetryOnErrorCodes("IncorrectStatus", "OperationConflict"))
+	assert.True(t, policy.Retryable(incorrectStatus))
+	assert.False(t, policy.Retryable(throttling))
+
+	policy = NewRetryPolicy(RetryOnThrottling, RetryOnErrorCodes("IncorrectStatus"))
+	assert.True(t, policy.Retryable(incorrectStatus))
+	assert.True(t, policy.Retryable(throttling))
+	assert.False(t, policy.Retryable(fmt.Errorf("InvalidParameter")))
+}
+
+func TestUnitCommonGetRetryPolicy(t *testing.T) {
+	client := &AliyunClient{config: &Config{MaxRetryTimeout: 60}}
+	assert.Nil(t, client.getRetryPolicy("Vpc"), "the request should not be retried without a policy")
+	assert.Equal(t, time.Minute, client.NewRetryPolicy(5*time.Minute).MaxElapsedTime)
+
+	client.config.RetryPolicies = map[string]RetryPolicy{
+		"vpc": {MaxAttempts: 3},
+		"log": {MaxInterval: 5 * time.Second},
+	}
+	policy := client.getRetryPolicy("Vpc")
+	assert.NotNil(t, policy)
+	assert.Equal(t, 3, policy.MaxAttempts)
+	assert.Equal(t, time.Minute, policy.MaxElapsedTime, "the max elapsed time should be tied to the max_retry_timeout")
+	assert.Len(t, policy.Classifiers, 1)
+
+	requestPolicy := NewRetryPolicy(RetryOnErrorCodes("IncorrectStatus"))
+	requestPolicy.MaxAttempts = 10
+	policy = client.getRetryPolicy("vpc", requestPolicy)
+	assert.Equal(t, 3, policy.MaxAttempts, "the provider setting should override the request policy")
+	assert.Equal(t, 10, requestPolicy.MaxAttempts, "the request policy should not be changed")
+	assert.True(t, policy.Retryable(&tea.SDKError{Code: tea.String("IncorrectStatus"), Message: tea.String(""), Data: tea.String("")}))
+
+	policy = client.getRetryPolicy("sls")
+	assert.NotNil(t, policy)
+	assert.Equal(t, 5*time.Second, policy.MaxInterval)
+
+	assert.True(t, client.getRetryPolicy("ecs", requestPolicy) == requestPolicy)
+}
+
+func TestUnitCommonRpcPostWithRetryPolicy(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"Code":"ServiceUnavailable","Message":"The request has failed due to a temporary failure of the server.","RequestId":"B5F1C4E2-4C3D-4B5A-9E3A-8F1D2C3B4A5E"}`)
+			return
+		}
+		fmt.Fprint(w, `{"RequestId":"B5F1C4E2-4C3D-4B5A-9E3A-8F1D2C3B4A5E"}`)
+	}))
+	defer server.Close()
+
+	client, fakeServer := newTeaPoolTestClient(t, &mockCredential{accessKeyId: "test-ak", accessKeySecret: "test-sk"})
+	fakeServer.Close()
+	client.config.Endpoints.Store("vpc", strings.TrimPrefix(server.URL, "http://"))
+
+	_, err := client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", nil, nil, false)
+	assert.NotNil(t, err, "the request should not be retried without a policy")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	policy := NewRetryPolicy()
+	policy.InitialInterval = time.Millisecond
+	response, err := client.RpcPost("Vpc", "2016-04-28", "DescribeVpcs", nil, nil, false, policy)
+	assert.Nil(t, err)
+	assert.Equal(t, "B5F1C4E2-4C3D-4B5A-9E3A-8F1D2C3B4A5E", response["RequestId"])
+	assert.Equal(t, int32(3), atomic.LoadInt32(&requests))
+}
@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The customize diff functions below validate the cross-field constraints at plan time, and they can be composed by
+// customdiff.All and customdiff.If. A constraint is only checked for a new resource or if one of its attributes has
+// a change, so the computed values in the state of the existing resources don't fail the plan. A constraint whose
+// attribute value is unknown at plan time, like a computed attribute which isn't set or an interpolation of another
+// resource's attribute, is left to the apply.
+
+// RequiredWhen requires the attribute key to be set when the attribute conditionKey is one of the values.
+func RequiredWhen(key, conditionKey string, values ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !customizeDiffShouldCheck(diff, key, conditionKey) {
+			return nil
+		}
+		condition, ok := diff.GetOk(conditionKey)
+		if !ok || !customizeDiffValueIn(condition, values) {
+			return nil
+		}
+		if _, ok := diff.GetOk(key); !ok {
+			return fmt.Errorf("%q is required when %q is %v", key, conditionKey, condition)
+		}
+		return nil
+	}
+}
+
+// ConflictsWhenValue refuses the attribute key to be set when the attribute conditionKey is one of the values.
+func ConflictsWhenValue(key, conditionKey string, values ...string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !customizeDiffShouldCheck(diff, key, conditionKey) {
+			return nil
+		}
+		condition, ok := diff.GetOk(conditionKey)
+		if !ok || !customizeDiffValueIn(condition, values) {
+			return nil
+		}
+		if _, ok := diff.GetOk(key); ok {
+			return fmt.Errorf("%q can not be set when %q is %v", key, conditionKey, condition)
+		}
+		return nil
+	}
+}
+
+// ForceNewIfDecreased marks the numeric attribute key as forcing a new resource if its new value is less than the old
+// one, like the size of a disk which can only be expanded.
+func ForceNewIfDecreased(key string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" || !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+		oldValue, newValue := diff.GetChange(key)
+		oldNumber, err := strconv.ParseFloat(fmt.Sprint(oldValue), 64)
+		if err != nil {
+			return WrapError(err)
+		}
+		newNumber, err := strconv.ParseFloat(fmt.Sprint(newValue), 64)
+		if err != nil {
+			return WrapError(err)
+		}
+		if newNumber < oldNumber {
+			return diff.ForceNew(key)
+		}
+		return nil
+	}
+}
+
+// AllowedTransitions only allows the attribute key of an existing resource to be changed from an old value to one of
+// the new values of the old value in the transitions. The old value which isn't in the transitions can not be changed.
+func AllowedTransitions(key string, transitions map[string][]string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() == "" || !diff.HasChange(key) || !diff.NewValueKnown(key) {
+			return nil
+		}
+		oldValue, newValue := diff.GetChange(key)
+		if fmt.Sprint(oldValue) == "" {
+			return nil
+		}
+		allowed := transitions[fmt.Sprint(oldValue)]
+		if customizeDiffValueIn(newValue, allowed) {
+			return nil
+		}
+		if len(allowed) == 0 {
+			return fmt.Errorf("%q can not be changed from %v", key, oldValue)
+		}
+		return fmt.Errorf("%q can not be changed from %v to %v, it can only be changed to %s", key, oldValue, newValue, strings.Join(allowed, ", "))
+	}
+}
+
+func customizeDiffShouldCheck(diff *schema.ResourceDiff, keys ...string) bool {
+	changed := diff.Id() == ""
+	for _, key := range keys {
+		if !diff.NewValueKnown(key) {
+			return false
+		}
+		changed = changed || diff.HasChange(key)
+	}
+	return changed
+}
+
+func customizeDiffValueIn(value interface{}, values []string) bool {
+	for _, v := range values {
+		if fmt.Sprint(value) == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCustomizeDiffResource(customizeDiff schema.CustomizeDiffFunc) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"instance_charge_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: customizeDiff,
+	}
+}
+
+func testCustomizeDiff(resource *schema.Resource, attributes map[string]string, config map[string]interface{}) (*terraform.InstanceDiff, error) {
+	var state *terraform.InstanceState
+	if attributes != nil {
+		state = &terraform.InstanceState{ID: "tf-test", Attributes: attributes}
+	}
+	return resource.Diff(state, terraform.NewResourceConfigRaw(config), nil)
+}
+
+func TestUnitCommonRequiredWhen(t *testing.T) {
+	resource := testCustomizeDiffResource(RequiredWhen("period", "instance_charge_type", "PrePaid"))
+
+	_, err := testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PrePaid"})
+	assert.NotNil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PrePaid", "period": 1})
+	assert.Nil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PostPaid"})
+	assert.Nil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{})
+	assert.Nil(t, err, "the unknown computed value should be left to the apply")
+
+	_, err = testCustomizeDiff(resource, map[string]string{"id": "tf-test", "instance_charge_type": "PostPaid"}, map[string]interface{}{"instance_charge_type": "PrePaid"})
+	assert.NotNil(t, err)
+}
+
+func TestUnitCommonConflictsWhenValue(t *testing.T) {
+	resource := testCustomizeDiffResource(ConflictsWhenValue("period", "instance_charge_type", "PostPaid"))
+
+	_, err := testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PostPaid", "period": 1})
+	assert.NotNil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PrePaid", "period": 1})
+	assert.Nil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "PostPaid"})
+	assert.Nil(t, err)
+
+	_, err = testCustomizeDiff(resource, map[string]string{"id": "tf-test", "instance_charge_type": "PrePaid", "period": "1"}, map[string]interface{}{"instance_charge_type": "PostPaid", "period": 1})
+	assert.NotNil(t, err)
+}
+
+func TestUnitCommonForceNewIfDecreased(t *testing.T) {
+	resource := testCustomizeDiffResource(ForceNewIfDecreased("size"))
+
+	diff, err := testCustomizeDiff(resource, map[string]string{"id": "tf-test", "size": "40"}, map[string]interface{}{"size": 20})
+	assert.Nil(t, err)
+	assert.True(t, diff.RequiresNew())
+
+	diff, err = testCustomizeDiff(resource, map[string]string{"id": "tf-test", "size": "40"}, map[string]interface{}{"size": 80})
+	assert.Nil(t, err)
+	assert.False(t, diff.RequiresNew())
+}
+
+func TestUnitCommonAllowedTransitions(t *testing.T) {
+	resource := testCustomizeDiffResource(AllowedTransitions("instance_charge_type", map[string][]string{
+		"PostPaid": {"PrePaid"},
+		"PrePaid":  {"PostPaid"},
+	}))
+
+	_, err := testCustomizeDiff(resource, map[string]string{"id": "tf-test", "instance_charge_type": "PostPaid"}, map[string]interface{}{"instance_charge_type": "PrePaid"})
+	assert.Nil(t, err)
+	_, err = testCustomizeDiff(resource, map[string]string{"id": "tf-test", "instance_charge_type": "PostPaid"}, map[string]interface{}{"instance_charge_type": "Serverless"})
+	assert.NotNil(t, err)
+	_, err = testCustomizeDiff(resource, map[string]string{"id": "tf-test", "instance_charge_type": "Serverless"}, map[string]interface{}{"instance_charge_type": "PrePaid"})
+	assert.NotNil(t, err)
+	_, err = testCustomizeDiff(resource, nil, map[string]interface{}{"instance_charge_type": "Serverless"})
+	assert.Nil(t, err, "the value of a new resource should not be checked")
+}
@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"regexp"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -154,6 +153,7 @@ func dataSourceAliCloudEcsDisks() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"max_results": dataSourceMaxResultsSchema(),
 			"disks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -436,105 +436,69 @@ func dataSourceAliCloudEcsDisksRead(d *schema.ResourceData, meta interface{}) er
 	if v, ok := d.GetOk("snapshot_id"); ok {
 		request["SnapshotId"] = v
 	}
-	if v, ok := d.GetOk("status"); ok {
-		request["Status"] = v
-	}
-	if v, ok := d.GetOk("tags"); ok {
-		tags := make([]map[string]interface{}, 0)
-		for key, value := range v.(map[string]interface{}) {
-			tags = append(tags, map[string]interface{}{
-				"Key":   key,
-				"Value": value.(string),
-			})
-		}
-		request["Tag"] = tags
-	}
 	if v, ok := d.GetOk("zone_id"); ok {
 		request["ZoneId"] = v
 	} else if v, ok := d.GetOk("availability_zone"); ok {
 		request["ZoneId"] = v
 	}
 
-	if v, ok := d.GetOk("page_number"); ok && v.(int) > 0 {
-		request["PageNumber"] = v.(int)
-	} else {
-		request["PageNumber"] = 1
-	}
-	if v, ok := d.GetOk("page_size"); ok && v.(int) > 0 {
-		request["PageSize"] = v.(int)
-	} else {
-		request["PageSize"] = PageSizeXLarge
-	}
-	var objects []interface{}
-	var diskNameRegex *regexp.Regexp
-	if v, ok := d.GetOk("name_regex"); ok {
-		r, err := regexp.Compile(v.(string))
-		if err != nil {
-			return WrapError(err)
-		}
-		diskNameRegex = r
-	}
-
-	idsMap := make(map[string]string)
-	if v, ok := d.GetOk("ids"); ok {
-		for _, vv := range v.([]interface{}) {
-			if vv == nil {
-				continue
-			}
-			idsMap[vv.(string)] = vv.(string)
-		}
-	}
-	var response map[string]interface{}
-	var err error
-	for {
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, request, true)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
+	lister := &dataSourceLister{
+		PageSize: PageSizeXLarge,
+		Fetch: func(page dataSourcePage) (*dataSourcePageResult, error) {
+			pageRequest := page.withPageNumber(request)
+			var response map[string]interface{}
+			var err error
+			wait := incrementalWait(3*time.Second, 3*time.Second)
+			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+				response, err = client.RpcPost("Ecs", "2014-05-26", action, nil, pageRequest, true)
+				if err != nil {
+					if NeedRetry(err) {
+						wait()
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
 				}
-				return resource.NonRetryableError(err)
+				addDebug(action, response, pageRequest)
+				return nil
+			})
+			if err != nil {
+				return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_ecs_disks", action, AlibabaCloudSdkGoERROR)
 			}
-			addDebug(action, response, request)
-			return nil
-		})
-		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_ecs_disks", action, AlibabaCloudSdkGoERROR)
-		}
-		resp, err := jsonpath.Get("$.Disks.Disk", response)
-		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.Disks.Disk", response)
-		}
-		result, _ := resp.([]interface{})
-		if isPagingRequest(d) {
-			objects = result
-			break
-		}
-		for _, v := range result {
-			item := v.(map[string]interface{})
-			if diskNameRegex != nil {
-				if !diskNameRegex.MatchString(fmt.Sprint(item["DiskName"])) {
-					continue
-				}
+			resp, err := jsonpath.Get("$.Disks.Disk", response)
+			if err != nil {
+				return nil, WrapErrorf(err, FailedGetAttributeMsg, action, "$.Disks.Disk", response)
 			}
-			if len(idsMap) > 0 {
-				if _, ok := idsMap[fmt.Sprint(item["DiskId"])]; !ok {
-					continue
-				}
+			result, _ := resp.([]interface{})
+			return &dataSourcePageResult{Objects: result, TotalCount: formatInt(response["TotalCount"])}, nil
+		},
+		IdOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["DiskId"])
+		},
+		NameOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["DiskName"])
+		},
+		PushTags: func(tags map[string]interface{}) {
+			tagsMaps := make([]map[string]interface{}, 0)
+			for key, value := range tags {
+				tagsMaps = append(tagsMaps, map[string]interface{}{
+					"Key":   key,
+					"Value": value.(string),
+				})
 			}
-			objects = append(objects, item)
-		}
-		if len(result) < request["PageSize"].(int) {
-			break
-		}
-		request["PageNumber"] = request["PageNumber"].(int) + 1
+			request["Tag"] = tagsMaps
+		},
+		PushStatus: func(status string) {
+			request["Status"] = status
+		},
+	}
+	listResult, err := lister.list(d)
+	if err != nil {
+		return WrapError(err)
 	}
 	ids := make([]string, 0)
 	names := make([]interface{}, 0)
 	s := make([]map[string]interface{}, 0)
-	for _, v := range objects {
+	for _, v := range listResult.Objects {
 		object := v.(map[string]interface{})
 		mapping := map[string]interface{}{
 			"attached_time":                    object["AttachedTime"],
@@ -640,7 +604,7 @@ func dataSourceAliCloudEcsDisksRead(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	if err := d.Set("total_count", formatInt(response["TotalCount"])); err != nil {
+	if err := d.Set("total_count", listResult.TotalCount); err != nil {
 		return WrapError(err)
 	}
 	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
@@ -1,8 +1,6 @@
 package alicloud
 
 import (
-	"regexp"
-	"strconv"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -84,6 +82,7 @@ func dataSourceAlicloudInstances() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"max_results": dataSourceMaxResultsSchema(),
 
 			// Computed values
 			"names": {
@@ -241,129 +240,115 @@ func dataSourceAlicloudInstances() *schema.Resource {
 func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
-	request := ecs.CreateDescribeInstancesRequest()
-	request.RegionId = client.RegionId
-	request.Status = d.Get("status").(string)
-
-	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
-		request.InstanceIds = convertListToJsonString(v.([]interface{}))
-	}
-	if v, ok := d.GetOk("vpc_id"); ok && v.(string) != "" {
-		request.VpcId = v.(string)
-	}
-	if v, ok := d.GetOk("vswitch_id"); ok && v.(string) != "" {
-		request.VSwitchId = v.(string)
-	}
-	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
-		request.ResourceGroupId = v.(string)
-	}
-	if v, ok := d.GetOk("availability_zone"); ok && v.(string) != "" {
-		request.ZoneId = v.(string)
-	}
+	var status, instanceName string
+	var tags []ecs.DescribeInstancesTag
 	if v, ok := d.GetOk("instance_name"); ok && v.(string) != "" {
-		request.InstanceName = v.(string)
+		instanceName = v.(string)
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		var tags []ecs.DescribeInstancesTag
-
-		for key, value := range v.(map[string]interface{}) {
-			tags = append(tags, ecs.DescribeInstancesTag{
-				Key:   key,
-				Value: value.(string),
-			})
+	newRequest := func() *ecs.DescribeInstancesRequest {
+		request := ecs.CreateDescribeInstancesRequest()
+		request.RegionId = client.RegionId
+		request.Status = status
+		request.InstanceName = instanceName
+		if len(tags) > 0 {
+			request.Tag = &tags
 		}
-		request.Tag = &tags
-	}
-
-	var allInstances []ecs.Instance
-
-	if v, ok := d.GetOk("page_number"); ok && v.(int) > 0 {
-		request.PageNumber = requests.NewInteger(v.(int))
-	} else {
-		request.PageNumber = requests.NewInteger(1)
-	}
-	if v, ok := d.GetOk("page_size"); ok && v.(int) > 0 {
-		request.PageSize = requests.NewInteger(v.(int))
-	} else {
-		request.PageSize = requests.NewInteger(PageSizeXLarge)
-	}
-	var response *ecs.DescribeInstancesResponse
-	for {
-		var raw interface{}
-		var err error
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.DescribeInstances(request)
-			})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-
-		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_instances", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+			request.InstanceIds = convertListToJsonString(v.([]interface{}))
 		}
-
-		response, _ = raw.(*ecs.DescribeInstancesResponse)
-		if isPagingRequest(d) {
-			allInstances = response.Instances.Instance
-			break
+		if v, ok := d.GetOk("vpc_id"); ok && v.(string) != "" {
+			request.VpcId = v.(string)
 		}
-		if len(response.Instances.Instance) < 1 {
-			break
+		if v, ok := d.GetOk("vswitch_id"); ok && v.(string) != "" {
+			request.VSwitchId = v.(string)
 		}
-
-		allInstances = append(allInstances, response.Instances.Instance...)
-		pageSize, err := strconv.Atoi(string(request.PageSize))
-		if err != nil {
-			return WrapError(err)
+		if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
+			request.ResourceGroupId = v.(string)
 		}
-
-		if len(response.Instances.Instance) < pageSize {
-			break
+		if v, ok := d.GetOk("availability_zone"); ok && v.(string) != "" {
+			request.ZoneId = v.(string)
 		}
-
-		if page, err := getNextpageNumber(request.PageNumber); err != nil {
-			return WrapError(err)
-		} else {
-			request.PageNumber = page
+		if v, ok := d.GetOk("image_id"); ok && v.(string) != "" {
+			request.ImageId = v.(string)
 		}
+		return request
 	}
 
-	var filteredInstancesTemp []ecs.Instance
+	lister := &dataSourceLister{
+		PageSize: PageSizeXLarge,
+		Fetch: func(page dataSourcePage) (*dataSourcePageResult, error) {
+			request := newRequest()
+			request.PageNumber = requests.NewInteger(page.Number)
+			request.PageSize = requests.NewInteger(page.Size)
+			var raw interface{}
+			var err error
+			wait := incrementalWait(3*time.Second, 3*time.Second)
+			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+				raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+					return ecsClient.DescribeInstances(request)
+				})
+				if err != nil {
+					if NeedRetry(err) {
+						wait()
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	nameRegex, ok := d.GetOk("name_regex")
-	imageId, okImg := d.GetOk("image_id")
-	if (ok && nameRegex.(string) != "") || (okImg && imageId.(string) != "") {
-		var instanceNameRegex *regexp.Regexp
-		if nameRegex != "" {
-			r, err := regexp.Compile(nameRegex.(string))
 			if err != nil {
-				return WrapError(err)
+				return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_instances", request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
-			instanceNameRegex = r
-		}
-		for _, inst := range allInstances {
-			if instanceNameRegex != nil && !instanceNameRegex.MatchString(inst.InstanceName) {
-				continue
+			response, _ := raw.(*ecs.DescribeInstancesResponse)
+			result := &dataSourcePageResult{
+				Objects:    make([]interface{}, 0, len(response.Instances.Instance)),
+				TotalCount: response.TotalCount,
 			}
-			if imageId.(string) != "" && inst.ImageId != imageId.(string) {
-				continue
+			for _, instance := range response.Instances.Instance {
+				result.Objects = append(result.Objects, instance)
 			}
-			filteredInstancesTemp = append(filteredInstancesTemp, inst)
-		}
-	} else {
-		filteredInstancesTemp = allInstances
+			return result, nil
+		},
+		IdOf: func(object interface{}) string {
+			return object.(ecs.Instance).InstanceId
+		},
+		NameOf: func(object interface{}) string {
+			return object.(ecs.Instance).InstanceName
+		},
+		Filter: func(object interface{}) bool {
+			v, ok := d.GetOk("image_id")
+			return !ok || v.(string) == "" || object.(ecs.Instance).ImageId == v.(string)
+		},
+		// DescribeInstances supports the wildcard * in the instance name.
+		PushNamePrefix: func(prefix string) {
+			if instanceName == "" {
+				instanceName = prefix + "*"
+			}
+		},
+		PushTags: func(v map[string]interface{}) {
+			for key, value := range v {
+				tags = append(tags, ecs.DescribeInstancesTag{
+					Key:   key,
+					Value: value.(string),
+				})
+			}
+		},
+		PushStatus: func(v string) {
+			status = v
+		},
+	}
+	listResult, err := lister.list(d)
+	if err != nil {
+		return WrapError(err)
+	}
+	filteredInstancesTemp := make([]ecs.Instance, 0, len(listResult.Objects))
+	for _, object := range listResult.Objects {
+		filteredInstancesTemp = append(filteredInstancesTemp, object.(ecs.Instance))
 	}
 	if v, ok := d.GetOkExists("enable_details"); !ok || !v.(bool) {
-		return instancessDescriptionAttributes(d, filteredInstancesTemp, nil, nil, meta, response.TotalCount)
+		return instancessDescriptionAttributes(d, filteredInstancesTemp, nil, nil, meta, listResult.TotalCount)
 	}
 	// Filter by ram role name and fetch the instance role name
 	instanceIds := make([]string, 0)
@@ -428,7 +413,7 @@ func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) e
 		return WrapError(err)
 	}
 
-	return instancessDescriptionAttributes(d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta, response.TotalCount)
+	return instancessDescriptionAttributes(d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta, listResult.TotalCount)
 }
 
 // populate the numerous fields that the instance description returns.
@@ -3,7 +3,6 @@ package alicloud
 import (
 	"log"
 	"net/http"
-	"regexp"
 	"strings"
 	"time"
 
@@ -35,6 +34,7 @@ func dataSourceAlicloudOssBucketObjects() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"max_results": dataSourceMaxResultsSchema(),
 
 			// Computed values
 			"objects": {
@@ -111,64 +111,63 @@ func dataSourceAlicloudOssBucketObjectsRead(d *schema.ResourceData, meta interfa
 	bucketName := d.Get("bucket_name").(string)
 
 	// List bucket objects
-	var initialOptions []oss.Option
+	keyPrefix := ""
 	if v, ok := d.GetOk("key_prefix"); ok && v.(string) != "" {
-		keyPrefix := v.(string)
-		initialOptions = append(initialOptions, oss.Prefix(keyPrefix))
-	}
-	var requestInfo *oss.Client
-	var allObjects []oss.ObjectProperties
-	nextMarker := ""
-	for {
-		var options []oss.Option
-		options = append(options, initialOptions...)
-		if nextMarker != "" {
-			options = append(options, oss.Marker(nextMarker))
-		}
-
-		raw, err := client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
-			requestInfo = &bucket.Client
-			return bucket.ListObjects(options...)
-		})
-		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_oss_bucket_object", "ListObjects", AliyunOssGoSdk)
-		}
-		if debugOn() {
-			addDebug("ListObjects", raw, requestInfo, map[string]interface{}{"options": options})
-		}
-		response, _ := raw.(oss.ListObjectsResult)
-
-		if response.Objects == nil || len(response.Objects) < 1 {
-			break
-		}
-
-		if len(response.Objects) < 1 {
-			break
-		}
-
-		allObjects = append(allObjects, response.Objects...)
-
-		nextMarker = response.NextMarker
-		if nextMarker == "" {
-			break
-		}
+		keyPrefix = v.(string)
 	}
+	lister := &dataSourceLister{
+		PageSize:            PageSizeXLarge,
+		NextTokenPagination: true,
+		Fetch: func(page dataSourcePage) (*dataSourcePageResult, error) {
+			options := []oss.Option{oss.MaxKeys(page.Size)}
+			if keyPrefix != "" {
+				options = append(options, oss.Prefix(keyPrefix))
+			}
+			if page.NextToken != "" {
+				options = append(options, oss.Marker(page.NextToken))
+			}
 
-	var filteredObjectsTemp []oss.ObjectProperties
-	keyRegex, ok := d.GetOk("key_regex")
-	if ok && keyRegex.(string) != "" {
-		var r *regexp.Regexp
-		if keyRegex != "" {
-			r = regexp.MustCompile(keyRegex.(string))
-		}
-		for _, object := range allObjects {
-			if r != nil && !r.MatchString(object.Key) {
-				continue
+			var requestInfo *oss.Client
+			raw, err := client.WithOssBucketByName(bucketName, func(bucket *oss.Bucket) (interface{}, error) {
+				requestInfo = &bucket.Client
+				return bucket.ListObjects(options...)
+			})
+			if err != nil {
+				return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_oss_bucket_object", "ListObjects", AliyunOssGoSdk)
 			}
-			filteredObjectsTemp = append(filteredObjectsTemp, object)
-		}
-	} else {
-		filteredObjectsTemp = allObjects
+			if debugOn() {
+				addDebug("ListObjects", raw, requestInfo, map[string]interface{}{"options": options})
+			}
+			response, _ := raw.(oss.ListObjectsResult)
+			result := &dataSourcePageResult{
+				Objects: make([]interface{}, 0, len(response.Objects)),
+			}
+			for _, object := range response.Objects {
+				result.Objects = append(result.Objects, object)
+			}
+			if response.IsTruncated {
+				result.NextToken = response.NextMarker
+			}
+			return result, nil
+		},
+		NameOf: func(object interface{}) string {
+			return object.(oss.ObjectProperties).Key
+		},
+		NameRegexKey: "key_regex",
+		// The literal prefix of key_regex narrows the listing unless key_prefix is more specific.
+		PushNamePrefix: func(prefix string) {
+			if strings.HasPrefix(prefix, keyPrefix) {
+				keyPrefix = prefix
+			}
+		},
+	}
+	listResult, err := lister.list(d)
+	if err != nil {
+		return WrapError(err)
+	}
+	filteredObjectsTemp := make([]oss.ObjectProperties, 0, len(listResult.Objects))
+	for _, object := range listResult.Objects {
+		filteredObjectsTemp = append(filteredObjectsTemp, object.(oss.ObjectProperties))
 	}
 
 	return bucketObjectsDescriptionAttributes(d, bucketName, filteredObjectsTemp, meta)
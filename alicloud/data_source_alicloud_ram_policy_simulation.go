@@ -0,0 +1,840 @@
+package alicloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceAliCloudRamPolicySimulation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudRamPolicySimulationRead,
+		Schema: map[string]*schema.Schema{
+			"policy_documents": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"request": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(ramPolicyRequestActionRegexp, "it must be formatted as <service>:<action>, like ecs:DescribeInstances"),
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "*",
+						},
+						"context": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"fail_on_error": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"valid": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"document_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"statement_index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"element": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"decision": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"matched_statements": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"document_index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"statement_index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"effect": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAliCloudRamPolicySimulationRead(d *schema.ResourceData, meta interface{}) error {
+	documents := expandStringList(d.Get("policy_documents").([]interface{}))
+
+	statements := make([]ramPolicyEvaluationStatement, 0)
+	findings := make([]ramPolicyFinding, 0)
+	for i, document := range documents {
+		parsed, lints := lintRamPolicyDocument(i, document)
+		statements = append(statements, parsed...)
+		findings = append(findings, lints...)
+	}
+
+	valid := true
+	errorMessages := make([]string, 0)
+	findingMappings := make([]map[string]interface{}, 0, len(findings))
+	for _, finding := range findings {
+		if finding.Severity == RamPolicyFindingError {
+			valid = false
+			errorMessages = append(errorMessages, finding.String())
+		}
+		findingMappings = append(findingMappings, map[string]interface{}{
+			"document_index":  finding.DocumentIndex,
+			"statement_index": finding.StatementIndex,
+			"severity":        finding.Severity,
+			"element":         finding.Element,
+			"message":         finding.Message,
+		})
+	}
+	if !valid && d.Get("fail_on_error").(bool) {
+		return WrapError(fmt.Errorf("the policy documents are invalid:\n%s", strings.Join(errorMessages, "\n")))
+	}
+
+	resultMappings := make([]map[string]interface{}, 0)
+	for _, raw := range d.Get("request").([]interface{}) {
+		item, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		request := ramPolicyEvaluationRequest{
+			Action:   item["action"].(string),
+			Resource: item["resource"].(string),
+			Context:  map[string][]string{},
+		}
+		for _, c := range item["context"].([]interface{}) {
+			if context, ok := c.(map[string]interface{}); ok {
+				key := strings.ToLower(context["key"].(string))
+				request.Context[key] = append(request.Context[key], expandStringList(context["values"].([]interface{}))...)
+			}
+		}
+
+		decision, matched := simulateRamPolicy(statements, request)
+		matchedMappings := make([]map[string]interface{}, 0, len(matched))
+		for _, statement := range matched {
+			matchedMappings = append(matchedMappings, map[string]interface{}{
+				"document_index":  statement.DocumentIndex,
+				"statement_index": statement.StatementIndex,
+				"effect":          statement.Effect,
+			})
+		}
+		resultMappings = append(resultMappings, map[string]interface{}{
+			"action":             request.Action,
+			"resource":           request.Resource,
+			"decision":           decision,
+			"allowed":            decision == RamPolicyDecisionAllowed,
+			"matched_statements": matchedMappings,
+		})
+	}
+
+	d.SetId(tea.ToString(helper.Hashcode(strings.Join(documents, "\n") + fmt.Sprint(d.Get("request")))))
+	d.Set("valid", valid)
+	if err := d.Set("findings", findingMappings); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("results", resultMappings); err != nil {
+		return WrapError(err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), map[string]interface{}{
+			"valid":    valid,
+			"findings": findingMappings,
+			"results":  resultMappings,
+		})
+	}
+	return nil
+}
+
+const (
+	RamPolicyFindingError   = "Error"
+	RamPolicyFindingWarning = "Warning"
+
+	RamPolicyDecisionAllowed      = "Allowed"
+	RamPolicyDecisionExplicitDeny = "ExplicitDeny"
+	RamPolicyDecisionImplicitDeny = "ImplicitDeny"
+)
+
+var (
+	ramPolicyRequestActionRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*:[A-Za-z0-9]+$`)
+	ramPolicyActionRegexp        = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*:[A-Za-z0-9*?]+$`)
+	ramPolicyArnServiceRegexp    = regexp.MustCompile(`^[a-z0-9*?-]+$`)
+	ramPolicyArnRegionRegexp     = regexp.MustCompile(`^[a-z0-9*?-]*$`)
+	ramPolicyArnAccountRegexp    = regexp.MustCompile(`^[0-9*?]*$`)
+	ramPolicyConditionKeyRegexp  = regexp.MustCompile(`^[A-Za-z0-9-]+:[^\s]+$`)
+)
+
+// ramPolicyConditionOperators are the condition operators of the RAM policy language by the type of their values.
+var ramPolicyConditionOperators = map[string]string{
+	"StringEquals":              "String",
+	"StringNotEquals":           "String",
+	"StringEqualsIgnoreCase":    "String",
+	"StringNotEqualsIgnoreCase": "String",
+	"StringLike":                "String",
+	"StringNotLike":             "String",
+	"NumericEquals":             "Numeric",
+	"NumericNotEquals":          "Numeric",
+	"NumericLessThan":           "Numeric",
+	"NumericLessThanEquals":     "Numeric",
+	"NumericGreaterThan":        "Numeric",
+	"NumericGreaterThanEquals":  "Numeric",
+	"DateEquals":                "Date",
+	"DateNotEquals":             "Date",
+	"DateLessThan":              "Date",
+	"DateLessThanEquals":        "Date",
+	"DateGreaterThan":           "Date",
+	"DateGreaterThanEquals":     "Date",
+	"Bool":                      "Boolean",
+	"IpAddress":                 "IP",
+	"NotIpAddress":              "IP",
+}
+
+// ramPolicyStatementElements are the elements allowed in a statement. Principal is only used by the trust policies of
+// the RAM roles.
+var ramPolicyStatementElements = []string{"Effect", "Action", "NotAction", "Resource", "NotResource", "Principal", "Condition"}
+
+// ramPolicyFinding is a problem found in a policy document. StatementIndex is -1 when it is about the whole document.
+type ramPolicyFinding struct {
+	DocumentIndex  int
+	StatementIndex int
+	Severity       string
+	Element        string
+	Message        string
+}
+
+func (f ramPolicyFinding) String() string {
+	if f.StatementIndex < 0 {
+		return fmt.Sprintf("[%s] policy_documents.%d %s: %s", f.Severity, f.DocumentIndex, f.Element, f.Message)
+	}
+	return fmt.Sprintf("[%s] policy_documents.%d Statement[%d].%s: %s", f.Severity, f.DocumentIndex, f.StatementIndex, f.Element, f.Message)
+}
+
+type ramPolicyEvaluationCondition struct {
+	Operator string
+	Key      string
+	Values   []string
+}
+
+// ramPolicyEvaluationStatement is a statement normalized for the simulation.
+type ramPolicyEvaluationStatement struct {
+	DocumentIndex  int
+	StatementIndex int
+	Effect         string
+	Action         []string
+	NotAction      []string
+	Resource       []string
+	NotResource    []string
+	Condition      []ramPolicyEvaluationCondition
+}
+
+// ramPolicyEvaluationRequest is a request of a principal. The keys of Context are in lower case.
+type ramPolicyEvaluationRequest struct {
+	Action   string
+	Resource string
+	Context  map[string][]string
+}
+
+// lintRamPolicyDocument validates the document against the RAM policy grammar, and returns the statements which can be
+// simulated together with the findings.
+func lintRamPolicyDocument(documentIndex int, document string) ([]ramPolicyEvaluationStatement, []ramPolicyFinding) {
+	findings := make([]ramPolicyFinding, 0)
+	report := func(statementIndex int, severity, element, format string, args ...interface{}) {
+		findings = append(findings, ramPolicyFinding{
+			DocumentIndex:  documentIndex,
+			StatementIndex: statementIndex,
+			Severity:       severity,
+			Element:        element,
+			Message:        fmt.Sprintf(format, args...),
+		})
+	}
+
+	var policy map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&policy); err != nil {
+		report(-1, RamPolicyFindingError, "Document", "it is not a valid JSON object: %s", err)
+		return nil, findings
+	}
+
+	for _, key := range ramPolicySortedKeys(policy) {
+		if key != "Version" && key != "Statement" {
+			report(-1, RamPolicyFindingError, key, "unknown element, only Version and Statement are supported")
+		}
+	}
+	if version, ok := policy["Version"]; !ok {
+		report(-1, RamPolicyFindingError, "Version", "the element is required")
+	} else if version != "1" {
+		report(-1, RamPolicyFindingError, "Version", "unsupported version %v, it must be \"1\"", version)
+	}
+
+	var rawStatements []interface{}
+	switch v := policy["Statement"].(type) {
+	case []interface{}:
+		rawStatements = v
+	case map[string]interface{}:
+		rawStatements = []interface{}{v}
+	case nil:
+		report(-1, RamPolicyFindingError, "Statement", "the element is required")
+	default:
+		report(-1, RamPolicyFindingError, "Statement", "it must be a list of statements")
+	}
+	if policy["Statement"] != nil && len(rawStatements) == 0 {
+		report(-1, RamPolicyFindingError, "Statement", "at least one statement is required")
+	}
+
+	statements := make([]ramPolicyEvaluationStatement, 0, len(rawStatements))
+	for i, raw := range rawStatements {
+		object, ok := raw.(map[string]interface{})
+		if !ok {
+			report(i, RamPolicyFindingError, "Statement", "it must be a JSON object")
+			continue
+		}
+		statement := ramPolicyEvaluationStatement{DocumentIndex: documentIndex, StatementIndex: i}
+		valid := true
+		fail := func(element, format string, args ...interface{}) {
+			valid = false
+			report(i, RamPolicyFindingError, element, format, args...)
+		}
+
+		for _, key := range ramPolicySortedKeys(object) {
+			if !ramPolicyIsStatementElement(key) {
+				if known := ramPolicyStatementElementFold(key); known != "" {
+					fail(key, "the elements are case sensitive, it must be %s", known)
+				} else {
+					report(i, RamPolicyFindingWarning, key, "unknown element, it is ignored")
+				}
+			}
+		}
+
+		switch effect := object["Effect"]; effect {
+		case "Allow", "Deny":
+			statement.Effect = effect.(string)
+		case nil:
+			fail("Effect", "the element is required")
+		default:
+			fail("Effect", "invalid value %v, it must be Allow or Deny", effect)
+		}
+
+		_, hasAction := object["Action"]
+		_, hasNotAction := object["NotAction"]
+		if hasAction == hasNotAction {
+			fail("Action", "exactly one of Action and NotAction is required")
+		}
+		for _, element := range []string{"Action", "NotAction"} {
+			raw, ok := object[element]
+			if !ok {
+				continue
+			}
+			values, ok := ramPolicyStringList(raw)
+			if !ok || len(values) == 0 {
+				fail(element, "it must be a string or a non-empty list of strings")
+				continue
+			}
+			for _, action := range values {
+				if action != "*" && !ramPolicyActionRegexp.MatchString(action) {
+					fail(element, "invalid action %q, it must be * or formatted as <service>:<action> whose service is in lower case", action)
+				}
+			}
+			if element == "Action" {
+				statement.Action = values
+			} else {
+				statement.NotAction = values
+			}
+		}
+
+		_, hasResource := object["Resource"]
+		_, hasNotResource := object["NotResource"]
+		_, hasPrincipal := object["Principal"]
+		if hasResource && hasNotResource {
+			fail("Resource", "only one of Resource and NotResource can be set")
+		} else if !hasResource && !hasNotResource && !hasPrincipal {
+			fail("Resource", "one of Resource and NotResource is required, unless the statement has a Principal of a trust policy")
+		}
+		for _, element := range []string{"Resource", "NotResource"} {
+			raw, ok := object[element]
+			if !ok {
+				continue
+			}
+			values, ok := ramPolicyStringList(raw)
+			if !ok || len(values) == 0 {
+				fail(element, "it must be a string or a non-empty list of strings")
+				continue
+			}
+			for _, arn := range values {
+				if message := ramPolicyValidateArn(arn); message != "" {
+					fail(element, "invalid resource %q: %s", arn, message)
+				}
+			}
+			if element == "Resource" {
+				statement.Resource = values
+			} else {
+				statement.NotResource = values
+			}
+		}
+		if hasPrincipal {
+			ramPolicyLintPrincipal(object["Principal"], func(format string, args ...interface{}) {
+				fail("Principal", format, args...)
+			})
+		}
+
+		if raw, ok := object["Condition"]; ok {
+			conditions, ok := raw.(map[string]interface{})
+			if !ok {
+				fail("Condition", "it must be a JSON object of the condition operators")
+			}
+			for _, operator := range ramPolicySortedKeys(conditions) {
+				valueType, known := ramPolicyConditionOperators[operator]
+				if !known {
+					fail("Condition", "unknown condition operator %s", operator)
+					continue
+				}
+				keys, ok := conditions[operator].(map[string]interface{})
+				if !ok || len(keys) == 0 {
+					fail("Condition", "the operator %s must be a non-empty JSON object of the condition keys", operator)
+					continue
+				}
+				for _, key := range ramPolicySortedKeys(keys) {
+					if !ramPolicyConditionKeyRegexp.MatchString(key) {
+						fail("Condition", "invalid condition key %q, it must be formatted as <namespace>:<name>, like acs:SourceIp", key)
+					}
+					values, ok := ramPolicyConditionValues(keys[key])
+					if !ok || len(values) == 0 {
+						fail("Condition", "the values of %s %s must be a scalar or a non-empty list of scalars", operator, key)
+						continue
+					}
+					for _, value := range values {
+						if err := ramPolicyValidateConditionValue(valueType, value); err != nil {
+							fail("Condition", "invalid value %q of %s %s: %s", value, operator, key, err)
+						}
+					}
+					statement.Condition = append(statement.Condition, ramPolicyEvaluationCondition{Operator: operator, Key: strings.ToLower(key), Values: values})
+				}
+			}
+		}
+
+		if !valid {
+			continue
+		}
+		// the advisory warnings are only reported for the statements without errors
+		if statement.Effect == "Allow" && len(statement.Condition) == 0 && ramPolicyContains(statement.Action, "*") && ramPolicyContains(statement.Resource, "*") {
+			report(i, RamPolicyFindingWarning, "Action", "the statement allows all of the actions on all of the resources")
+		}
+		if namespaces := ramPolicyActionNamespaces(statement.Action); len(namespaces) > 0 {
+			for _, arn := range statement.Resource {
+				parts := strings.SplitN(arn, ":", 5)
+				if len(parts) == 5 && !strings.ContainsAny(parts[1], "*?") && !namespaces[parts[1]] {
+					report(i, RamPolicyFindingWarning, "Resource", "the service %s of the resource %q does not match any of the action namespaces", parts[1], arn)
+				}
+			}
+		}
+		statements = append(statements, statement)
+	}
+	return statements, findings
+}
+
+// simulateRamPolicy evaluates the request against the statements. An explicit deny overrides any allow, and the request
+// is denied implicitly when no statement allows it. All of the statements matching the request are returned.
+func simulateRamPolicy(statements []ramPolicyEvaluationStatement, request ramPolicyEvaluationRequest) (string, []ramPolicyEvaluationStatement) {
+	matched := make([]ramPolicyEvaluationStatement, 0)
+	decision := RamPolicyDecisionImplicitDeny
+	for _, statement := range statements {
+		if !ramPolicyStatementMatches(statement, request) {
+			continue
+		}
+		matched = append(matched, statement)
+		if statement.Effect == "Deny" {
+			decision = RamPolicyDecisionExplicitDeny
+		} else if decision != RamPolicyDecisionExplicitDeny {
+			decision = RamPolicyDecisionAllowed
+		}
+	}
+	return decision, matched
+}
+
+func ramPolicyStatementMatches(statement ramPolicyEvaluationStatement, request ramPolicyEvaluationRequest) bool {
+	if len(statement.Action) > 0 && !ramPolicyMatchAny(statement.Action, request.Action, true) {
+		return false
+	}
+	if len(statement.NotAction) > 0 && ramPolicyMatchAny(statement.NotAction, request.Action, true) {
+		return false
+	}
+	if len(statement.Resource) > 0 && !ramPolicyMatchAny(statement.Resource, request.Resource, false) {
+		return false
+	}
+	if len(statement.NotResource) > 0 && ramPolicyMatchAny(statement.NotResource, request.Resource, false) {
+		return false
+	}
+	for _, condition := range statement.Condition {
+		if !ramPolicyConditionMatches(condition, request.Context[condition.Key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// ramPolicyConditionMatches checks the values of the context key against the condition. The condition is satisfied
+// when any of the values matches any of the condition values, and the negated operators are satisfied when none of
+// them matches. A key missing from the context only satisfies the negated operators.
+func ramPolicyConditionMatches(condition ramPolicyEvaluationCondition, values []string) bool {
+	operator := condition.Operator
+	negated := strings.Contains(operator, "Not")
+	if negated {
+		operator = strings.Replace(operator, "Not", "", 1)
+	}
+	matched := false
+	for _, value := range values {
+		for _, expected := range condition.Values {
+			if ramPolicyConditionValueMatches(operator, value, expected) {
+				matched = true
+			}
+		}
+	}
+	return matched != negated
+}
+
+func ramPolicyConditionValueMatches(operator, value, expected string) bool {
+	switch operator {
+	case "StringEquals":
+		return value == expected
+	case "StringEqualsIgnoreCase":
+		return strings.EqualFold(value, expected)
+	case "StringLike":
+		return ramPolicyWildcardMatch(expected, value, false)
+	case "Bool":
+		return strings.EqualFold(value, expected)
+	case "IpAddress":
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return false
+		}
+		if _, network, err := net.ParseCIDR(expected); err == nil {
+			return network.Contains(ip)
+		}
+		return ip.Equal(net.ParseIP(expected))
+	}
+
+	var compare int
+	if strings.HasPrefix(operator, "Numeric") {
+		a, errA := strconv.ParseFloat(value, 64)
+		b, errB := strconv.ParseFloat(expected, 64)
+		if errA != nil || errB != nil {
+			return false
+		}
+		compare = ramPolicyCompare(a < b, a > b)
+		operator = strings.TrimPrefix(operator, "Numeric")
+	} else if strings.HasPrefix(operator, "Date") {
+		a, errA := ramPolicyParseDate(value)
+		b, errB := ramPolicyParseDate(expected)
+		if errA != nil || errB != nil {
+			return false
+		}
+		compare = ramPolicyCompare(a.Before(b), a.After(b))
+		operator = strings.TrimPrefix(operator, "Date")
+	} else {
+		return false
+	}
+	switch operator {
+	case "Equals":
+		return compare == 0
+	case "LessThan":
+		return compare < 0
+	case "LessThanEquals":
+		return compare <= 0
+	case "GreaterThan":
+		return compare > 0
+	case "GreaterThanEquals":
+		return compare >= 0
+	}
+	return false
+}
+
+func ramPolicyCompare(less, greater bool) int {
+	if less {
+		return -1
+	}
+	if greater {
+		return 1
+	}
+	return 0
+}
+
+func ramPolicyParseDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("it must be a date in ISO 8601 format, like 2024-01-01T00:00:00Z")
+}
+
+func ramPolicyValidateConditionValue(valueType, value string) error {
+	switch valueType {
+	case "Numeric":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("it must be a number")
+		}
+	case "Date":
+		if _, err := ramPolicyParseDate(value); err != nil {
+			return err
+		}
+	case "Boolean":
+		if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+			return fmt.Errorf("it must be true or false")
+		}
+	case "IP":
+		if _, _, err := net.ParseCIDR(value); err != nil && net.ParseIP(value) == nil {
+			return fmt.Errorf("it must be an IP address or a CIDR block")
+		}
+	}
+	return nil
+}
+
+// ramPolicyValidateArn returns why the resource is not * or an ARN formatted as
+// acs:<service>:<region>:<account>:<relative-id>, or an empty string.
+func ramPolicyValidateArn(arn string) string {
+	if arn == "*" {
+		return ""
+	}
+	parts := strings.SplitN(arn, ":", 5)
+	if len(parts) != 5 || parts[0] != "acs" {
+		return "it must be * or formatted as acs:<service>:<region>:<account>:<relative-id>"
+	}
+	if !ramPolicyArnServiceRegexp.MatchString(parts[1]) {
+		return fmt.Sprintf("the service %q must be in lower case", parts[1])
+	}
+	if !ramPolicyArnRegionRegexp.MatchString(parts[2]) {
+		return fmt.Sprintf("the region %q must be a region id, * or empty", parts[2])
+	}
+	if !ramPolicyArnAccountRegexp.MatchString(parts[3]) {
+		return fmt.Sprintf("the account %q must be an account id, * or empty", parts[3])
+	}
+	if parts[4] == "" {
+		return "the relative id of the resource is required"
+	}
+	return ""
+}
+
+func ramPolicyLintPrincipal(raw interface{}, fail func(format string, args ...interface{})) {
+	if raw == "*" {
+		return
+	}
+	principals, ok := raw.(map[string]interface{})
+	if !ok || len(principals) == 0 {
+		fail("it must be * or a non-empty JSON object of RAM, Service or Federated principals")
+		return
+	}
+	for _, entity := range ramPolicySortedKeys(principals) {
+		if entity != "RAM" && entity != "Service" && entity != "Federated" {
+			fail("unknown principal type %s, it must be RAM, Service or Federated", entity)
+		}
+		if values, ok := ramPolicyStringList(principals[entity]); !ok || len(values) == 0 {
+			fail("the %s principals must be a string or a non-empty list of strings", entity)
+		}
+	}
+}
+
+// ramPolicyWildcardMatch matches the value against the pattern, in which * matches any sequence of characters and ?
+// matches any single character.
+func ramPolicyWildcardMatch(pattern, value string, ignoreCase bool) bool {
+	var expr strings.Builder
+	if ignoreCase {
+		expr.WriteString("(?i)")
+	}
+	expr.WriteString("^")
+	for _, c := range pattern {
+		switch c {
+		case '*':
+			expr.WriteString(".*")
+		case '?':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.MustCompile(expr.String()).MatchString(value)
+}
+
+func ramPolicyMatchAny(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if ramPolicyWildcardMatch(pattern, value, ignoreCase) {
+			return true
+		}
+	}
+	return false
+}
+
+// ramPolicyActionNamespaces returns the services of the actions, or nil when any of them is *.
+func ramPolicyActionNamespaces(actions []string) map[string]bool {
+	namespaces := make(map[string]bool)
+	for _, action := range actions {
+		parts := strings.SplitN(action, ":", 2)
+		if len(parts) != 2 {
+			return nil
+		}
+		namespaces[parts[0]] = true
+	}
+	return namespaces
+}
+
+func ramPolicyStringList(raw interface{}) ([]string, bool) {
+	switch v := raw.(type) {
+	case string:
+		return []string{v}, v != ""
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok || s == "" {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+// ramPolicyConditionValues converts the condition values, which may be strings, numbers or booleans, to strings.
+func ramPolicyConditionValues(raw interface{}) ([]string, bool) {
+	items, ok := raw.([]interface{})
+	if !ok {
+		items = []interface{}{raw}
+	}
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			result = append(result, v)
+		case json.Number:
+			result = append(result, v.String())
+		case bool:
+			result = append(result, strconv.FormatBool(v))
+		default:
+			return nil, false
+		}
+	}
+	return result, true
+}
+
+func ramPolicyIsStatementElement(key string) bool {
+	return ramPolicyContains(ramPolicyStatementElements, key)
+}
+
+func ramPolicyStatementElementFold(key string) string {
+	for _, element := range ramPolicyStatementElements {
+		if strings.EqualFold(element, key) {
+			return element
+		}
+	}
+	return ""
+}
+
+func ramPolicyContains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func ramPolicySortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
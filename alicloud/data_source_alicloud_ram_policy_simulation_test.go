@@ -0,0 +1,173 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudRamPolicySimulationDataSource(t *testing.T) {
+	resourceId := "data.alicloud_ram_policy_simulation.default"
+	testAccCheck := resourceAttrInit(resourceId, map[string]string{}).resourceAttrMapUpdateSet()
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAliCloudRamPolicySimulationDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"valid":                          "true",
+						"findings.#":                     "0",
+						"results.#":                      "3",
+						"results.0.decision":             "Allowed",
+						"results.0.allowed":              "true",
+						"results.0.matched_statements.#": "1",
+						"results.1.decision":             "ExplicitDeny",
+						"results.1.matched_statements.#": "2",
+						"results.2.decision":             "ImplicitDeny",
+						"results.2.matched_statements.#": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAliCloudRamPolicySimulationDataSourceConfig = `
+data "alicloud_ram_policy_document" "default" {
+  statement {
+    effect   = "Allow"
+    action   = ["oss:Get*", "oss:ListObjects"]
+    resource = ["acs:oss:*:*:myphotos", "acs:oss:*:*:myphotos/*"]
+  }
+  statement {
+    effect   = "Deny"
+    action   = ["oss:*"]
+    resource = ["acs:oss:*:*:myphotos/private/*"]
+  }
+}
+
+data "alicloud_ram_policy_simulation" "default" {
+  policy_documents = [data.alicloud_ram_policy_document.default.document]
+  request {
+    action   = "oss:GetObject"
+    resource = "acs:oss:*:*:myphotos/2024/a.jpg"
+  }
+  request {
+    action   = "oss:GetObject"
+    resource = "acs:oss:*:*:myphotos/private/a.jpg"
+  }
+  request {
+    action   = "oss:PutObject"
+    resource = "acs:oss:*:*:myphotos/2024/a.jpg"
+  }
+}
+`
+
+func TestUnitAliCloudRamPolicySimulationLint(t *testing.T) {
+	cases := []struct {
+		document string
+		errors   []string
+		warnings []string
+	}{
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":["ecs:Describe*","vpc:DescribeVpcs"],"Resource":"*"}]}`,
+		},
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole","Principal":{"Service":["ecs.aliyuncs.com"]}}]}`,
+		},
+		{
+			document: `{"Version":"1","Statement":[`,
+			errors:   []string{"Document"},
+		},
+		{
+			document: `{"Version":"2","Statement":[],"Id":"x"}`,
+			errors:   []string{"Id", "Statement", "Version"},
+		},
+		{
+			document: `{"Version":"1","Statement":[{"effect":"Allow","Action":"ECS:DescribeInstances","Resource":"arn:ecs:*:*:*"}]}`,
+			errors:   []string{"effect", "Effect", "Action", "Resource"},
+		},
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":"*","NotAction":"ecs:*","Resource":"*","NotResource":"acs:ecs:*:*:instance/*"}]}`,
+			errors:   []string{"Action", "Resource"},
+		},
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":"ecs:*","Resource":"acs:ecs:CN-hangzhou:abc:instance/*"}]}`,
+			errors:   []string{"Resource"},
+		},
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":"ecs:*","Resource":"*","Condition":{"StringEqual":{"acs:SourceVpc":"vpc-1"},"IpAddress":{"acs:SourceIp":["10.0.0.0/8","not-an-ip"]},"NumericLessThan":{"ecs:Count":"ten"},"DateLessThan":{"acs:CurrentTime":"tomorrow"},"Bool":{"acs:SecureTransport":true,"SecureTransport":"yes"}}}]}`,
+			errors:   []string{"Condition", "Condition", "Condition", "Condition", "Condition", "Condition"},
+		},
+		{
+			document: `{"Version":"1","Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Sid":"all"},{"Effect":"Allow","Action":"oss:GetObject","Resource":"acs:ecs:*:*:instance/*"}]}`,
+			warnings: []string{"Action", "Sid", "Resource"},
+		},
+	}
+	for i, c := range cases {
+		_, findings := lintRamPolicyDocument(i, c.document)
+		errors := make([]string, 0)
+		warnings := make([]string, 0)
+		for _, finding := range findings {
+			assert.Equal(t, i, finding.DocumentIndex)
+			if finding.Severity == RamPolicyFindingError {
+				errors = append(errors, finding.Element)
+			} else {
+				warnings = append(warnings, finding.Element)
+			}
+		}
+		assert.ElementsMatch(t, c.errors, errors, c.document)
+		assert.ElementsMatch(t, c.warnings, warnings, c.document)
+	}
+}
+
+func TestUnitAliCloudRamPolicySimulationSimulate(t *testing.T) {
+	document := `{
+  "Version": "1",
+  "Statement": [
+    {"Effect": "Allow", "Action": "ecs:*", "Resource": "acs:ecs:cn-hangzhou:*:instance/*",
+     "Condition": {"IpAddress": {"acs:SourceIp": "10.0.0.0/8"}, "Bool": {"acs:SecureTransport": true}}},
+    {"Effect": "Deny", "Action": "ecs:DeleteInstance", "Resource": "*",
+     "Condition": {"StringNotEquals": {"ecs:tag/env": "test"}}},
+    {"Effect": "Allow", "NotAction": ["ram:*", "sts:*"], "Resource": "*",
+     "Condition": {"DateLessThan": {"acs:CurrentTime": "2030-01-01T00:00:00Z"}, "NumericGreaterThanEquals": {"acs:MFAAge": "0"}}}
+  ]
+}`
+	statements, findings := lintRamPolicyDocument(0, document)
+	assert.Len(t, findings, 0)
+	assert.Len(t, statements, 3)
+
+	instance := "acs:ecs:cn-hangzhou:123456:instance/i-1"
+	context := map[string][]string{"acs:sourceip": {"10.1.2.3"}, "acs:securetransport": {"TRUE"}}
+	cases := []struct {
+		action   string
+		resource string
+		context  map[string][]string
+		decision string
+		matched  []int
+	}{
+		{"ecs:DescribeInstances", instance, context, RamPolicyDecisionAllowed, []int{0}},
+		{"ECS:StartInstance", instance, context, RamPolicyDecisionAllowed, []int{0}},
+		{"ecs:DescribeInstances", instance, map[string][]string{"acs:sourceip": {"192.168.0.1"}, "acs:securetransport": {"true"}}, RamPolicyDecisionImplicitDeny, []int{}},
+		{"ecs:DescribeInstances", "acs:ecs:cn-beijing:123456:instance/i-1", context, RamPolicyDecisionImplicitDeny, []int{}},
+		{"ecs:DeleteInstance", instance, context, RamPolicyDecisionExplicitDeny, []int{0, 1}},
+		{"ecs:DeleteInstance", instance, map[string][]string{"acs:sourceip": {"10.1.2.3"}, "acs:securetransport": {"true"}, "ecs:tag/env": {"test"}}, RamPolicyDecisionAllowed, []int{0}},
+		{"vpc:DescribeVpcs", "*", map[string][]string{"acs:currenttime": {"2024-06-01T00:00:00Z"}, "acs:mfaage": {"30"}}, RamPolicyDecisionAllowed, []int{2}},
+		{"vpc:DescribeVpcs", "*", map[string][]string{"acs:currenttime": {"2031-06-01T00:00:00Z"}, "acs:mfaage": {"30"}}, RamPolicyDecisionImplicitDeny, []int{}},
+		{"ram:CreateUser", "*", map[string][]string{"acs:currenttime": {"2024-06-01T00:00:00Z"}, "acs:mfaage": {"30"}}, RamPolicyDecisionImplicitDeny, []int{}},
+	}
+	for _, c := range cases {
+		decision, matched := simulateRamPolicy(statements, ramPolicyEvaluationRequest{Action: c.action, Resource: c.resource, Context: c.context})
+		assert.Equal(t, c.decision, decision, c.action+" "+c.resource)
+		indexes := make([]int, 0)
+		for _, statement := range matched {
+			indexes = append(indexes, statement.StatementIndex)
+		}
+		assert.Equal(t, c.matched, indexes, c.action+" "+c.resource)
+	}
+}
@@ -0,0 +1,247 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceAliCloudTagNonCompliantResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudTagNonCompliantResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"resource_types": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(configResourceTypeRegexp, "it must be a resource type of Cloud Config, like ACS::ECS::Instance"),
+				},
+			},
+			"required_tags": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+						"reasons": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var configResourceTypeRegexp = regexp.MustCompile(`^ACS::[A-Za-z0-9]+::[A-Za-z0-9]+$`)
+
+// tagComplianceRule requires the tag key on the resources. When Values is not empty, the value of the tag must be one of them.
+type tagComplianceRule struct {
+	Key    string
+	Values []string
+}
+
+func dataSourceAliCloudTagNonCompliantResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	configServiceV2 := ConfigServiceV2{client}
+	tagServiceV2 := TagServiceV2{client}
+
+	resourceTypes := expandStringList(d.Get("resource_types").([]interface{}))
+	rules := make([]tagComplianceRule, 0)
+	for _, v := range d.Get("required_tags").([]interface{}) {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, tagComplianceRule{
+			Key:    item["key"].(string),
+			Values: expandStringList(item["values"].([]interface{})),
+		})
+	}
+
+	objects, err := configServiceV2.ListConfigDiscoveredResources(resourceTypes)
+	if err != nil {
+		return WrapError(err)
+	}
+	arns := make([]string, 0, len(objects))
+	for _, object := range objects {
+		arns = append(arns, configResourceArn(object))
+	}
+	tagsByArn, err := tagServiceV2.ListTagResourcesByArn(arns)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	ids := make([]string, 0)
+	s := make([]map[string]interface{}, 0)
+	for i, object := range objects {
+		// The tags recorded by Cloud Config are used when the resource type is not supported by the Tag service.
+		tags, ok := tagsByArn[arns[i]]
+		if !ok {
+			tags = configResourceTags(object["Tags"])
+		}
+		reasons := tagComplianceReasons(tags, rules)
+		if len(reasons) == 0 {
+			continue
+		}
+		resourceTags := make(map[string]interface{}, len(tags))
+		for key, value := range tags {
+			resourceTags[key] = value
+		}
+		mapping := map[string]interface{}{
+			"arn":           arns[i],
+			"resource_id":   fmt.Sprint(object["ResourceId"]),
+			"resource_type": fmt.Sprint(object["ResourceType"]),
+			"resource_name": fmt.Sprint(object["ResourceName"]),
+			"region_id":     fmt.Sprint(object["Region"]),
+			"tags":          resourceTags,
+			"reasons":       reasons,
+		}
+		ids = append(ids, arns[i])
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(append(append([]string{}, resourceTypes...), arns...)))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("resources", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+
+	return nil
+}
+
+// tagComplianceReasons returns the reasons why the tags do not follow the rules, or nil if they do.
+func tagComplianceReasons(tags map[string]string, rules []tagComplianceRule) []string {
+	var reasons []string
+	for _, rule := range rules {
+		value, ok := tags[rule.Key]
+		if !ok {
+			reasons = append(reasons, fmt.Sprintf("missing the required tag %q", rule.Key))
+			continue
+		}
+		if len(rule.Values) == 0 {
+			continue
+		}
+		allowed := false
+		for _, v := range rule.Values {
+			if v == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			reasons = append(reasons, fmt.Sprintf("the value %q of the tag %q is not one of [%s]", value, rule.Key, strings.Join(rule.Values, ", ")))
+		}
+	}
+	return reasons
+}
+
+// configResourceArn builds the ARN of a resource discovered by Cloud Config, like acs:ecs:cn-hangzhou:123456789:instance/i-abc123.
+func configResourceArn(object map[string]interface{}) string {
+	parts := strings.Split(fmt.Sprint(object["ResourceType"]), "::")
+	service, resourceType := "", ""
+	if len(parts) == 3 {
+		service, resourceType = strings.ToLower(parts[1]), strings.ToLower(parts[2])
+	}
+	if v, ok := configResourceArnTypes[fmt.Sprint(object["ResourceType"])]; ok {
+		resourceType = v
+	}
+	region := fmt.Sprint(object["Region"])
+	if region == "global" {
+		region = ""
+	}
+	return fmt.Sprintf("acs:%s:%s:%v:%s/%v", service, region, object["AccountId"], resourceType, object["ResourceId"])
+}
+
+// configResourceArnTypes maps the resource types of Cloud Config whose ARN resource type is not their lower-cased name.
+var configResourceArnTypes = map[string]string{
+	"ACS::ECS::NetworkInterface": "eni",
+	"ACS::SLB::LoadBalancer":     "instance",
+}
+
+// configResourceTags parses the tags recorded by Cloud Config, like {"env":["test"]}.
+func configResourceTags(raw interface{}) map[string]string {
+	tags := make(map[string]string)
+	v, ok := raw.(string)
+	if !ok || v == "" {
+		return tags
+	}
+	var values map[string][]string
+	if err := json.Unmarshal([]byte(v), &values); err != nil {
+		return tags
+	}
+	for key, value := range values {
+		tags[key] = ""
+		if len(value) > 0 {
+			tags[key] = value[0]
+		}
+	}
+	return tags
+}
@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudTagNonCompliantResourcesDataSource(t *testing.T) {
+	checkoutSupportedRegions(t, true, connectivity.TagSupportRegions)
+	rand := acctest.RandIntRange(10000, 99999)
+
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccCheckAliCloudTagNonCompliantResourcesDataSourceConfig(rand, `["test"]`),
+		fakeConfig:  testAccCheckAliCloudTagNonCompliantResourcesDataSourceConfig(rand, `["dev"]`),
+	}
+
+	var existAliCloudTagNonCompliantResourcesDataSourceNameMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                     CHECKSET,
+			"resources.#":               CHECKSET,
+			"resources.0.arn":           CHECKSET,
+			"resources.0.resource_id":   CHECKSET,
+			"resources.0.resource_type": "ACS::VPC::VPC",
+			"resources.0.region_id":     CHECKSET,
+			"resources.0.reasons.#":     CHECKSET,
+		}
+	}
+
+	var fakeAliCloudTagNonCompliantResourcesDataSourceNameMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#": CHECKSET,
+		}
+	}
+
+	var aliCloudTagNonCompliantResourcesCheckInfo = dataSourceAttr{
+		resourceId:   "data.alicloud_tag_non_compliant_resources.default",
+		existMapFunc: existAliCloudTagNonCompliantResourcesDataSourceNameMapFunc,
+		fakeMapFunc:  fakeAliCloudTagNonCompliantResourcesDataSourceNameMapFunc,
+	}
+
+	preCheck := func() {
+		testAccPreCheck(t)
+	}
+
+	aliCloudTagNonCompliantResourcesCheckInfo.dataSourceTestCheckWithPreCheck(t, rand, preCheck, allConf)
+}
+
+func testAccCheckAliCloudTagNonCompliantResourcesDataSourceConfig(rand int, values string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "tf-testacc-tagnoncompliant-%d"
+}
+
+resource "alicloud_vpc" "default" {
+  vpc_name   = var.name
+  cidr_block = "10.4.0.0/16"
+  tags = {
+    env = "dev"
+  }
+}
+
+data "alicloud_tag_non_compliant_resources" "default" {
+  resource_types = ["ACS::VPC::VPC"]
+  required_tags {
+    key    = "env"
+    values = %s
+  }
+  depends_on = [alicloud_vpc.default]
+}
+`, rand, values)
+}
+
+func TestUnitAliCloudTagNonCompliantResources(t *testing.T) {
+	rules := []tagComplianceRule{
+		{Key: "created_by"},
+		{Key: "env", Values: []string{"test", "prod"}},
+	}
+	assert.Nil(t, tagComplianceReasons(map[string]string{"created_by": "terraform", "env": "prod"}, rules))
+	assert.Equal(t, []string{
+		`missing the required tag "created_by"`,
+		`the value "dev" of the tag "env" is not one of [test, prod]`,
+	}, tagComplianceReasons(map[string]string{"env": "dev"}, rules))
+	assert.Equal(t, []string{
+		`missing the required tag "created_by"`,
+		`missing the required tag "env"`,
+	}, tagComplianceReasons(map[string]string{}, rules))
+
+	assert.Equal(t, "acs:ecs:cn-hangzhou:123456789:instance/i-abc123", configResourceArn(map[string]interface{}{
+		"ResourceType": "ACS::ECS::Instance",
+		"Region":       "cn-hangzhou",
+		"AccountId":    "123456789",
+		"ResourceId":   "i-abc123",
+	}))
+	assert.Equal(t, "acs:ecs:cn-hangzhou:123456789:eni/eni-abc123", configResourceArn(map[string]interface{}{
+		"ResourceType": "ACS::ECS::NetworkInterface",
+		"Region":       "cn-hangzhou",
+		"AccountId":    "123456789",
+		"ResourceId":   "eni-abc123",
+	}))
+	assert.Equal(t, "acs:ram::123456789:role/tf-test", configResourceArn(map[string]interface{}{
+		"ResourceType": "ACS::RAM::Role",
+		"Region":       "global",
+		"AccountId":    "123456789",
+		"ResourceId":   "tf-test",
+	}))
+
+	assert.Equal(t, map[string]string{"env": "test", "owner": ""}, configResourceTags(`{"env":["test"],"owner":[]}`))
+	assert.Empty(t, configResourceTags(""))
+	assert.Empty(t, configResourceTags("invalid"))
+}
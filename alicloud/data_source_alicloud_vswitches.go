@@ -2,7 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"regexp"
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
@@ -76,6 +75,7 @@ func dataSourceAlicloudVswitches() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"max_results": dataSourceMaxResultsSchema(),
 			"vswitches": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -175,16 +175,6 @@ func dataSourceAlicloudVswitchesRead(d *schema.ResourceData, meta interface{}) e
 	if v, ok := d.GetOk("route_table_id"); ok {
 		request["RouteTableId"] = v
 	}
-	if v, ok := d.GetOk("tags"); ok {
-		tags := make([]map[string]interface{}, 0)
-		for key, value := range v.(map[string]interface{}) {
-			tags = append(tags, map[string]interface{}{
-				"Key":   key,
-				"Value": value.(string),
-			})
-		}
-		request["Tag"] = tags
-	}
 	if v, ok := d.GetOk("vswitch_name"); ok {
 		request["VSwitchName"] = v
 	}
@@ -197,71 +187,57 @@ func dataSourceAlicloudVswitchesRead(d *schema.ResourceData, meta interface{}) e
 	if v, ok := d.GetOk("zone_id"); ok {
 		request["ZoneId"] = v
 	}
-	request["PageSize"] = PageSizeLarge
-	request["PageNumber"] = 1
-	var objects []map[string]interface{}
-	var vSwitchNameRegex *regexp.Regexp
-	if v, ok := d.GetOk("name_regex"); ok {
-		r, err := regexp.Compile(v.(string))
-		if err != nil {
-			return WrapError(err)
-		}
-		vSwitchNameRegex = r
-	}
-
-	idsMap := make(map[string]string)
-	if v, ok := d.GetOk("ids"); ok {
-		for _, vv := range v.([]interface{}) {
-			if vv == nil {
-				continue
+	lister := &dataSourceLister{
+		PageSize: PageSizeLarge,
+		Fetch: func(page dataSourcePage) (*dataSourcePageResult, error) {
+			pageRequest := page.withPageNumber(request)
+			response, err := client.RpcPost("Vpc", "2016-04-28", action, nil, pageRequest, true)
+			if err != nil {
+				return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_vswitches", action, AlibabaCloudSdkGoERROR)
 			}
-			idsMap[vv.(string)] = vv.(string)
-		}
-	}
-	status, statusOk := d.GetOk("status")
-	var response map[string]interface{}
-	var err error
-	for {
-		response, err = client.RpcPost("Vpc", "2016-04-28", action, nil, request, true)
-		if err != nil {
-			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_vswitches", action, AlibabaCloudSdkGoERROR)
-		}
-		addDebug(action, response, request)
+			addDebug(action, response, pageRequest)
 
-		resp, err := jsonpath.Get("$.VSwitches.VSwitch", response)
-		if err != nil {
-			return WrapErrorf(err, FailedGetAttributeMsg, action, "$.VSwitches.VSwitch", response)
-		}
-		result, _ := resp.([]interface{})
-		for _, v := range result {
-			item := v.(map[string]interface{})
-			if vSwitchNameRegex != nil {
-				if !vSwitchNameRegex.MatchString(fmt.Sprint(item["VSwitchName"])) {
-					continue
-				}
-			}
-			if len(idsMap) > 0 {
-				if _, ok := idsMap[fmt.Sprint(item["VSwitchId"])]; !ok {
-					continue
-				}
+			resp, err := jsonpath.Get("$.VSwitches.VSwitch", response)
+			if err != nil {
+				return nil, WrapErrorf(err, FailedGetAttributeMsg, action, "$.VSwitches.VSwitch", response)
 			}
-			if v, ok := d.GetOk("cidr_block"); ok && fmt.Sprint(item["CidrBlock"]) != Trim(v.(string)) {
-				continue
-			}
-			if statusOk && status.(string) != "" && status.(string) != item["Status"].(string) {
-				continue
+			result, _ := resp.([]interface{})
+			return &dataSourcePageResult{Objects: result, TotalCount: formatInt(response["TotalCount"])}, nil
+		},
+		IdOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["VSwitchId"])
+		},
+		NameOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["VSwitchName"])
+		},
+		// DescribeVSwitches does not support filtering by status.
+		StatusOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["Status"])
+		},
+		Filter: func(object interface{}) bool {
+			v, ok := d.GetOk("cidr_block")
+			return !ok || fmt.Sprint(object.(map[string]interface{})["CidrBlock"]) == Trim(v.(string))
+		},
+		PushTags: func(tags map[string]interface{}) {
+			tagsMaps := make([]map[string]interface{}, 0)
+			for key, value := range tags {
+				tagsMaps = append(tagsMaps, map[string]interface{}{
+					"Key":   key,
+					"Value": value.(string),
+				})
 			}
-			objects = append(objects, item)
-		}
-		if len(result) < PageSizeLarge {
-			break
-		}
-		request["PageNumber"] = request["PageNumber"].(int) + 1
+			request["Tag"] = tagsMaps
+		},
+	}
+	listResult, err := lister.list(d)
+	if err != nil {
+		return WrapError(err)
 	}
 	ids := make([]string, 0)
 	names := make([]interface{}, 0)
 	s := make([]map[string]interface{}, 0)
-	for _, object := range objects {
+	for _, v := range listResult.Objects {
+		object := v.(map[string]interface{})
 		ipv6CidrBlock := fmt.Sprint(object["Ipv6CidrBlock"])
 		if object["Ipv6CidrBlock"] == nil {
 			ipv6CidrBlock = ""
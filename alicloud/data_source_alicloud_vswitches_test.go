@@ -111,6 +111,18 @@ func TestAccAliCloudVPCVSwitchesDataSourceBasic(t *testing.T) {
 			"resource_group_id": fmt.Sprintf(`"%s_fake"`, os.Getenv("ALICLOUD_RESOURCE_GROUP_ID")),
 		}),
 	}
+	maxResultsConf := dataSourceTestAccConfig{
+		existConfig: testAccCheckAlicloudVSwitchesDataSourceConfig(rand, map[string]string{
+			"name_regex":  `"^${alicloud_vswitch.default.vswitch_name}"`,
+			"vpc_id":      `"${alicloud_vpc.default.id}"`,
+			"max_results": `1`,
+		}),
+		fakeConfig: testAccCheckAlicloudVSwitchesDataSourceConfig(rand, map[string]string{
+			"name_regex":  `"^${alicloud_vswitch.default.vswitch_name}"`,
+			"vpc_id":      `"${alicloud_vpc.default.id}_fake"`,
+			"max_results": `1`,
+		}),
+	}
 	allConf := dataSourceTestAccConfig{
 		existConfig: testAccCheckAlicloudVSwitchesDataSourceConfig(rand, map[string]string{
 			"name_regex":        `"${alicloud_vswitch.default.vswitch_name}"`,
@@ -134,7 +146,7 @@ func TestAccAliCloudVPCVSwitchesDataSourceBasic(t *testing.T) {
 		}),
 	}
 
-	vswitchesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, statusConf, cidrBlockConf, idDefaultConf, vpcIdConf, zoneIdConf, tagsConf, resourceGroupIdConf, allConf)
+	vswitchesCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, statusConf, cidrBlockConf, idDefaultConf, vpcIdConf, zoneIdConf, tagsConf, resourceGroupIdConf, maxResultsConf, allConf)
 
 }
 
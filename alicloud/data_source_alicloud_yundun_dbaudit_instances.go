@@ -228,14 +228,5 @@ func dbauditTagsToMap(tags []yundun_dbaudit.TagResource) map[string]string {
 }
 
 func dbauditTagIgnored(t yundun_dbaudit.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
@@ -0,0 +1,281 @@
+package alicloud
+
+import (
+	"regexp"
+	"regexp/syntax"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// DataSourceListConcurrency is the default number of the pages which are fetched at the same time.
+const DataSourceListConcurrency = 5
+
+// dataSourcePage is the page requested by the dataSourceLister. Number is set for the APIs paginated by PageNumber,
+// and NextToken is set for the APIs paginated by NextToken or Marker, except the first page.
+type dataSourcePage struct {
+	Number    int
+	Size      int
+	NextToken string
+}
+
+// withPageNumber copies the request of an API paginated by PageNumber and sets the page, so that the pages can be
+// fetched at the same time.
+func (page dataSourcePage) withPageNumber(request map[string]interface{}) map[string]interface{} {
+	pageRequest := make(map[string]interface{}, len(request)+2)
+	for key, value := range request {
+		pageRequest[key] = value
+	}
+	pageRequest["PageNumber"] = page.Number
+	pageRequest["PageSize"] = page.Size
+	return pageRequest
+}
+
+// dataSourcePageResult is a page returned by the API. TotalCount is 0 when the API does not return it.
+type dataSourcePageResult struct {
+	Objects    []interface{}
+	TotalCount int
+	NextToken  string
+}
+
+// dataSourceLister lists the objects of a data source page by page, and filters them by the common arguments ids,
+// name_regex and status. The filters are pushed down to the API where it is supported, and the pages are fetched
+// concurrently when the API returns the total count. Only the filtered objects are kept, and the listing stops when
+// max_results objects are found.
+type dataSourceLister struct {
+	// PageSize is the size of the pages when page_size is not set.
+	PageSize int
+	// Concurrency bounds the number of the pages fetched at the same time. Defaults to DataSourceListConcurrency.
+	Concurrency int
+	// Fetch requests a page from the API. It is called concurrently, so it must not share the request between pages.
+	Fetch func(page dataSourcePage) (*dataSourcePageResult, error)
+	// NextTokenPagination is true if the API is paginated by NextToken or Marker, whose pages are fetched one by one.
+	NextTokenPagination bool
+
+	IdOf   func(object interface{}) string
+	NameOf func(object interface{}) string
+	// StatusOf is used to filter the objects by status on the client side when PushStatus is not set.
+	StatusOf func(object interface{}) string
+	// Filter is an additional filter applied on the client side.
+	Filter func(object interface{}) bool
+
+	// NameRegexKey is the argument filtering the objects by name. Defaults to name_regex.
+	NameRegexKey string
+	// PushNamePrefix receives the literal prefix of an anchored name_regex, like tf-test of ^tf-test-[0-9]+, which
+	// every matched name starts with.
+	PushNamePrefix func(prefix string)
+	// PushTags receives the tags argument.
+	PushTags func(tags map[string]interface{})
+	// PushStatus receives the status argument.
+	PushStatus func(status string)
+}
+
+// dataSourceListResult is the filtered objects in the order returned by the API.
+type dataSourceListResult struct {
+	Objects    []interface{}
+	TotalCount int
+}
+
+// dataSourceMaxResultsSchema is the schema of max_results which stops listing after the number of objects are found.
+func dataSourceMaxResultsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+func (l *dataSourceLister) list(d *schema.ResourceData) (*dataSourceListResult, error) {
+	filter, err := l.newFilter(d)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	page := dataSourcePage{Number: 1, Size: l.PageSize}
+	if v, ok := d.GetOk("page_size"); ok && v.(int) > 0 {
+		page.Size = v.(int)
+	}
+	if page.Size <= 0 {
+		page.Size = PageSizeLarge
+	}
+	maxResults := 0
+	if v, ok := d.GetOk("max_results"); ok {
+		maxResults = v.(int)
+	}
+	result := &dataSourceListResult{Objects: make([]interface{}, 0)}
+	full := func() bool {
+		return maxResults > 0 && len(result.Objects) >= maxResults
+	}
+	collect := func(objects []interface{}) {
+		for _, object := range objects {
+			if full() {
+				return
+			}
+			if filter(object) {
+				result.Objects = append(result.Objects, object)
+			}
+		}
+	}
+
+	if isPagingRequest(d) {
+		page.Number = d.Get("page_number").(int)
+		response, err := l.Fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		collect(response.Objects)
+		result.TotalCount = response.TotalCount
+		return result, nil
+	}
+
+	response, err := l.Fetch(page)
+	if err != nil {
+		return nil, err
+	}
+	collect(response.Objects)
+	result.TotalCount = response.TotalCount
+	concurrency := l.Concurrency
+	if concurrency <= 0 {
+		concurrency = DataSourceListConcurrency
+	}
+	if !l.NextTokenPagination && response.TotalCount > 0 && concurrency > 1 {
+		lastPage := (response.TotalCount + page.Size - 1) / page.Size
+		for start := 2; start <= lastPage && !full(); start += concurrency {
+			end := start + concurrency - 1
+			if end > lastPage {
+				end = lastPage
+			}
+			responses, err := l.fetchPages(page.Size, start, end)
+			if err != nil {
+				return nil, err
+			}
+			for _, response := range responses {
+				collect(response.Objects)
+			}
+		}
+		return result, nil
+	}
+	for !full() {
+		if l.NextTokenPagination {
+			if response.NextToken == "" {
+				break
+			}
+			page.NextToken = response.NextToken
+		} else {
+			if len(response.Objects) < page.Size {
+				break
+			}
+			page.Number++
+		}
+		response, err = l.Fetch(page)
+		if err != nil {
+			return nil, err
+		}
+		collect(response.Objects)
+	}
+	return result, nil
+}
+
+// fetchPages fetches the pages from start to end at the same time, and returns them in order.
+func (l *dataSourceLister) fetchPages(size, start, end int) ([]*dataSourcePageResult, error) {
+	responses := make([]*dataSourcePageResult, end-start+1)
+	errs := make([]error, end-start+1)
+	var wg sync.WaitGroup
+	for number := start; number <= end; number++ {
+		wg.Add(1)
+		go func(i int, page dataSourcePage) {
+			defer wg.Done()
+			responses[i], errs[i] = l.Fetch(page)
+		}(number-start, dataSourcePage{Number: number, Size: size})
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+// newFilter pushes the filters down to the API, and returns the filter of the rest on the client side.
+func (l *dataSourceLister) newFilter(d *schema.ResourceData) (func(object interface{}) bool, error) {
+	idsMap := make(map[string]struct{})
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			if id != nil {
+				idsMap[id.(string)] = struct{}{}
+			}
+		}
+	}
+
+	nameRegexKey := l.NameRegexKey
+	if nameRegexKey == "" {
+		nameRegexKey = "name_regex"
+	}
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk(nameRegexKey); ok && v.(string) != "" {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		nameRegex = r
+		if prefix := regexpLiteralPrefix(v.(string)); prefix != "" && l.PushNamePrefix != nil {
+			l.PushNamePrefix(prefix)
+		}
+	}
+
+	if v, ok := d.GetOk("tags"); ok && l.PushTags != nil {
+		l.PushTags(v.(map[string]interface{}))
+	}
+
+	status := ""
+	if v, ok := d.GetOk("status"); ok && v.(string) != "" {
+		if l.PushStatus != nil {
+			l.PushStatus(v.(string))
+		} else if l.StatusOf != nil {
+			status = v.(string)
+		}
+	}
+
+	return func(object interface{}) bool {
+		if len(idsMap) > 0 && l.IdOf != nil {
+			if _, ok := idsMap[l.IdOf(object)]; !ok {
+				return false
+			}
+		}
+		if nameRegex != nil && l.NameOf != nil && !nameRegex.MatchString(l.NameOf(object)) {
+			return false
+		}
+		if status != "" && l.StatusOf(object) != status {
+			return false
+		}
+		if l.Filter != nil && !l.Filter(object) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// regexpLiteralPrefix returns the literal prefix which all the strings matched by the regular expression start with.
+// It is empty unless the expression is anchored by ^ and starts with case-sensitive literals.
+func regexpLiteralPrefix(expr string) string {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return ""
+	}
+	subs := []*syntax.Regexp{re}
+	if re.Op == syntax.OpConcat {
+		subs = re.Sub
+	}
+	if len(subs) < 2 || subs[0].Op != syntax.OpBeginText {
+		return ""
+	}
+	var prefix []rune
+	for _, sub := range subs[1:] {
+		if sub.Op != syntax.OpLiteral || sub.Flags&syntax.FoldCase != 0 {
+			break
+		}
+		prefix = append(prefix, sub.Rune...)
+	}
+	return string(prefix)
+}
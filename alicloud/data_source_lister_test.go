@@ -0,0 +1,212 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDataSourceListerSchema = map[string]*schema.Schema{
+	"ids": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"name_regex": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"tags": tagsSchema(),
+	"page_number": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"page_size": {
+		Type:     schema.TypeInt,
+		Optional: true,
+	},
+	"max_results": dataSourceMaxResultsSchema(),
+}
+
+// testDataSourceObjects returns the objects named tf-test-0, tf-test-1 and so on, whose status is Available if the index is even.
+func testDataSourceObjects(count int) []interface{} {
+	objects := make([]interface{}, 0, count)
+	for i := 0; i < count; i++ {
+		status := "Available"
+		if i%2 == 1 {
+			status = "Pending"
+		}
+		objects = append(objects, map[string]interface{}{
+			"Id":     fmt.Sprintf("id-%d", i),
+			"Name":   fmt.Sprintf("tf-test-%d", i),
+			"Status": status,
+		})
+	}
+	return objects
+}
+
+// testDataSourceLister returns the lister of the objects paginated by PageNumber, or by NextToken if byToken is true.
+func testDataSourceLister(objects []interface{}, byToken bool, fetched *[]int) *dataSourceLister {
+	var mutex sync.Mutex
+	return &dataSourceLister{
+		PageSize:            5,
+		NextTokenPagination: byToken,
+		Fetch: func(page dataSourcePage) (*dataSourcePageResult, error) {
+			number := page.Number
+			if byToken && page.NextToken != "" {
+				number, _ = strconv.Atoi(page.NextToken)
+			}
+			mutex.Lock()
+			*fetched = append(*fetched, number)
+			mutex.Unlock()
+			start := (number - 1) * page.Size
+			if start > len(objects) {
+				start = len(objects)
+			}
+			end := start + page.Size
+			if end > len(objects) {
+				end = len(objects)
+			}
+			result := &dataSourcePageResult{Objects: objects[start:end]}
+			if byToken {
+				if end < len(objects) {
+					result.NextToken = strconv.Itoa(number + 1)
+				}
+			} else {
+				result.TotalCount = len(objects)
+			}
+			return result, nil
+		},
+		IdOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["Id"])
+		},
+		NameOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["Name"])
+		},
+		StatusOf: func(object interface{}) string {
+			return fmt.Sprint(object.(map[string]interface{})["Status"])
+		},
+	}
+}
+
+func testDataSourceListIds(result *dataSourceListResult) []string {
+	ids := make([]string, 0, len(result.Objects))
+	for _, object := range result.Objects {
+		ids = append(ids, fmt.Sprint(object.(map[string]interface{})["Id"]))
+	}
+	return ids
+}
+
+func TestUnitCommonDataSourceListerByPageNumber(t *testing.T) {
+	objects := testDataSourceObjects(23)
+
+	var fetched []int
+	d := schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{})
+	result, err := testDataSourceLister(objects, false, &fetched).list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, 23, len(result.Objects))
+	assert.Equal(t, 23, result.TotalCount)
+	assert.Equal(t, "id-22", testDataSourceListIds(result)[22])
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, fetched)
+
+	fetched = nil
+	d = schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{
+		"name_regex": "^tf-test-1",
+		"status":     "Available",
+	})
+	result, err = testDataSourceLister(objects, false, &fetched).list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id-10", "id-12", "id-14", "id-16", "id-18"}, testDataSourceListIds(result))
+
+	fetched = nil
+	d = schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{
+		"ids":         []interface{}{"id-3", "id-7", "id-8"},
+		"max_results": 2,
+	})
+	lister := testDataSourceLister(objects, false, &fetched)
+	lister.Concurrency = 1
+	result, err = lister.list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id-3", "id-7"}, testDataSourceListIds(result))
+	assert.Equal(t, []int{1, 2}, fetched)
+
+	fetched = nil
+	d = schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{
+		"page_number": 3,
+		"page_size":   4,
+	})
+	result, err = testDataSourceLister(objects, false, &fetched).list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id-8", "id-9", "id-10", "id-11"}, testDataSourceListIds(result))
+	assert.Equal(t, []int{3}, fetched)
+}
+
+func TestUnitCommonDataSourceListerByNextToken(t *testing.T) {
+	objects := testDataSourceObjects(12)
+
+	var fetched []int
+	var prefix, status string
+	var tags map[string]interface{}
+	d := schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{
+		"name_regex": "^tf-test-1[01]$",
+		"status":     "Pending",
+		"tags": map[string]interface{}{
+			"Created": "TF",
+		},
+	})
+	lister := testDataSourceLister(objects, true, &fetched)
+	lister.PushNamePrefix = func(v string) {
+		prefix = v
+	}
+	lister.PushStatus = func(v string) {
+		status = v
+	}
+	lister.PushTags = func(v map[string]interface{}) {
+		tags = v
+	}
+	result, err := lister.list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-test-1", prefix)
+	assert.Equal(t, "Pending", status)
+	assert.Equal(t, map[string]interface{}{"Created": "TF"}, tags)
+	// The status is not filtered on the client side after it is pushed down.
+	assert.Equal(t, []string{"id-10", "id-11"}, testDataSourceListIds(result))
+	assert.Equal(t, []int{1, 2, 3}, fetched)
+
+	fetched = nil
+	d = schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{
+		"max_results": 3,
+	})
+	result, err = testDataSourceLister(objects, true, &fetched).list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"id-0", "id-1", "id-2"}, testDataSourceListIds(result))
+	assert.Equal(t, []int{1}, fetched)
+
+	// The listing stops without NextToken even if the page is full.
+	fetched = nil
+	d = schema.TestResourceDataRaw(t, testDataSourceListerSchema, map[string]interface{}{})
+	result, err = testDataSourceLister(testDataSourceObjects(5), true, &fetched).list(d)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(result.Objects))
+	assert.Equal(t, []int{1}, fetched)
+}
+
+func TestUnitCommonRegexpLiteralPrefix(t *testing.T) {
+	assert.Equal(t, "tf-test", regexpLiteralPrefix("^tf-test"))
+	assert.Equal(t, "tf-test-", regexpLiteralPrefix("^tf-test-[0-9]+$"))
+	assert.Equal(t, "tf-tes", regexpLiteralPrefix("^tf-test?"))
+	assert.Equal(t, "tf.test", regexpLiteralPrefix(`^tf\.test.*`))
+	assert.Equal(t, "", regexpLiteralPrefix("tf-test"))
+	assert.Equal(t, "", regexpLiteralPrefix("^(?i)tf-test"))
+	assert.Equal(t, "", regexpLiteralPrefix("^tf-test|^tf-example"))
+	assert.Equal(t, "", regexpLiteralPrefix("^[a-z]+"))
+	assert.Equal(t, "", regexpLiteralPrefix("^("))
+}
@@ -0,0 +1,197 @@
+package alicloud
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ImportIdFormat declares the layout of the resource id accepted by terraform import, like
+// <load_balancer_id>:<protocol>:<frontend_port>.
+type ImportIdFormat struct {
+	// Parts are the names of the parts of the id in order.
+	Parts []string
+	// Patterns validate the parts by their names. A part without a pattern only needs to be non-empty.
+	Patterns map[string]string
+	// Separator separates the parts, and it defaults to ":".
+	Separator string
+	// Prefix is the constant leading part of the id, like user in user:<policy_name>:<policy_type>:<user_name>.
+	Prefix string
+}
+
+func (format ImportIdFormat) separator() string {
+	if format.Separator == "" {
+		return COLON_SEPARATED
+	}
+	return format.Separator
+}
+
+// String returns the layout of the id, like <load_balancer_id>:<protocol>:<frontend_port>.
+func (format ImportIdFormat) String() string {
+	parts := make([]string, 0, len(format.Parts)+1)
+	if format.Prefix != "" {
+		parts = append(parts, format.Prefix)
+	}
+	for _, part := range format.Parts {
+		parts = append(parts, "<"+part+">")
+	}
+	return strings.Join(parts, format.separator())
+}
+
+// validate checks the id has all of the parts in order, and returns the reason if it does not.
+func (format ImportIdFormat) validate(id string) error {
+	parts := strings.Split(id, format.separator())
+	if format.Prefix != "" {
+		if parts[0] != format.Prefix {
+			return fmt.Errorf("expected the prefix %q, got %q", format.Prefix, parts[0])
+		}
+		parts = parts[1:]
+	}
+	if len(parts) != len(format.Parts) {
+		return fmt.Errorf("expected %d parts separated by %q, got %d", len(format.Parts), format.separator(), len(parts))
+	}
+	for i, name := range format.Parts {
+		if parts[i] == "" {
+			return fmt.Errorf("the part %s is empty", name)
+		}
+		if pattern, ok := format.Patterns[name]; ok && !regexp.MustCompile(pattern).MatchString(parts[i]) {
+			return fmt.Errorf("the part %s %q does not match %s", name, parts[i], pattern)
+		}
+	}
+	return nil
+}
+
+// ImportIdAlternative is an alternative identifier accepted by terraform import, like the name or the ARN of a
+// resource. Resolve returns the resource id it resolves to, or an empty string if the identifier is not this kind of
+// alternative or no resource is found by it.
+type ImportIdAlternative struct {
+	Description string
+	Resolve     func(client *connectivity.AliyunClient, id string) (string, error)
+}
+
+// ImportStateWithIdFormat returns an importer which validates the import id by the format before the resource is read,
+// so a malformed id fails with the expected layout instead of a parsing error in Read. An id which does not match the
+// format is resolved by the alternatives in order.
+func ImportStateWithIdFormat(format ImportIdFormat, alternatives ...ImportIdAlternative) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client, _ := meta.(*connectivity.AliyunClient)
+			id, err := resolveImportId(client, strings.TrimSpace(d.Id()), format, alternatives)
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func resolveImportId(client *connectivity.AliyunClient, id string, format ImportIdFormat, alternatives []ImportIdAlternative) (string, error) {
+	invalid := format.validate(id)
+	if invalid == nil {
+		return id, nil
+	}
+	expected := []string{format.String()}
+	for _, alternative := range alternatives {
+		resolved, err := alternative.Resolve(client, id)
+		if err != nil {
+			return "", WrapError(fmt.Errorf("resolving the import id %q by %s got an error: %v", id, alternative.Description, err))
+		}
+		if resolved != "" {
+			return resolved, nil
+		}
+		expected = append(expected, alternative.Description)
+	}
+	return "", WrapError(fmt.Errorf("invalid import id %q: %v. Expected %s.", id, invalid, strings.Join(expected, ", or ")))
+}
+
+// importIdFromFormat accepts an id in another format as is, like the id format before a provider version.
+func importIdFromFormat(format ImportIdFormat) ImportIdAlternative {
+	return ImportIdAlternative{
+		Description: format.String(),
+		Resolve: func(client *connectivity.AliyunClient, id string) (string, error) {
+			if format.validate(id) != nil {
+				return "", nil
+			}
+			return id, nil
+		},
+	}
+}
+
+// importIdFromArn resolves the ARN acs:<service>:<region>:<account_id>:<resourceType>/<id> to the id. The ARN of the
+// resources without a resource type, like an OSS bucket, is acs:<service>:<region>:<account_id>:<id>.
+func importIdFromArn(service, resourceType string) ImportIdAlternative {
+	resource := "<id>"
+	if resourceType != "" {
+		resource = resourceType + "/<id>"
+	}
+	return ImportIdAlternative{
+		Description: fmt.Sprintf("the ARN acs:%s:<region>:<account_id>:%s", service, resource),
+		Resolve: func(client *connectivity.AliyunClient, id string) (string, error) {
+			parts := strings.SplitN(id, COLON_SEPARATED, 5)
+			if len(parts) != 5 || parts[0] != "acs" || parts[1] != service {
+				return "", nil
+			}
+			if resourceType == "" {
+				if strings.Contains(parts[4], SLASH_SEPARATED) {
+					return "", nil
+				}
+				return parts[4], nil
+			}
+			if !strings.HasPrefix(parts[4], resourceType+SLASH_SEPARATED) {
+				return "", nil
+			}
+			return strings.TrimPrefix(parts[4], resourceType+SLASH_SEPARATED), nil
+		},
+	}
+}
+
+// importIdByName resolves the name of a resource to its id by the RPC API which lists the resources, like
+// DescribeVpcs. The request sets the name by nameParam, and listPath points to the resources in the response. The
+// name must match exactly one resource.
+func importIdByName(product, version, action, nameParam, listPath, nameField, idField string, request map[string]interface{}) ImportIdAlternative {
+	return ImportIdAlternative{
+		Description: "the name of the resource",
+		Resolve: func(client *connectivity.AliyunClient, name string) (string, error) {
+			if client == nil || strings.Contains(name, COLON_SEPARATED) {
+				return "", nil
+			}
+			body := map[string]interface{}{
+				"RegionId": client.RegionId,
+				nameParam:  name,
+			}
+			for key, value := range request {
+				body[key] = value
+			}
+			response, err := client.RpcPost(product, version, action, nil, body, true)
+			addDebug(action, response, body)
+			if err != nil {
+				return "", WrapErrorf(err, DefaultErrorMsg, name, action, AlibabaCloudSdkGoERROR)
+			}
+			v, err := jsonpath.Get(listPath, response)
+			if err != nil {
+				return "", nil
+			}
+			items, _ := v.([]interface{})
+			var ids []string
+			for _, item := range items {
+				object, ok := item.(map[string]interface{})
+				if !ok || fmt.Sprint(object[nameField]) != name {
+					continue
+				}
+				ids = append(ids, fmt.Sprint(object[idField]))
+			}
+			if len(ids) > 1 {
+				return "", fmt.Errorf("%d resources are named %q: %s. Please import it by the id", len(ids), name, strings.Join(ids, ", "))
+			}
+			if len(ids) == 0 {
+				return "", nil
+			}
+			return ids[0], nil
+		},
+	}
+}
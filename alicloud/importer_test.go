@@ -0,0 +1,134 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func testImportState(importer *schema.ResourceImporter, id string) (string, error) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Importer: importer,
+	}
+	d := resource.Data(&terraform.InstanceState{ID: id})
+	results, err := importer.State(d, nil)
+	if err != nil {
+		return "", err
+	}
+	return results[0].Id(), nil
+}
+
+func TestUnitCommonImportIdFormat(t *testing.T) {
+	format := ImportIdFormat{
+		Parts: []string{"load_balancer_id", "protocol", "frontend_port"},
+		Patterns: map[string]string{
+			"frontend_port": `^[0-9]+$`,
+		},
+	}
+	assert.Equal(t, "<load_balancer_id>:<protocol>:<frontend_port>", format.String())
+	assert.Nil(t, format.validate("lb-abc123:tcp:80"))
+	assert.EqualError(t, format.validate("lb-abc123:80"), `expected 3 parts separated by ":", got 2`)
+	assert.EqualError(t, format.validate("lb-abc123::80"), "the part protocol is empty")
+	assert.EqualError(t, format.validate("lb-abc123:tcp:http"), `the part frontend_port "http" does not match ^[0-9]+$`)
+
+	format = ImportIdFormat{
+		Parts:     []string{"server_group_id", "server_id", "port"},
+		Separator: "_",
+	}
+	assert.Equal(t, "<server_group_id>_<server_id>_<port>", format.String())
+	assert.Nil(t, format.validate("sgp-abc123_i-abc123_80"))
+	assert.NotNil(t, format.validate("sgp-abc123:i-abc123:80"))
+
+	format = ImportIdFormat{
+		Parts:  []string{"policy_name", "policy_type", "user_name"},
+		Prefix: "user",
+	}
+	assert.Equal(t, "user:<policy_name>:<policy_type>:<user_name>", format.String())
+	assert.Nil(t, format.validate("user:AdministratorAccess:System:tf-test"))
+	assert.EqualError(t, format.validate("role:AdministratorAccess:System:tf-test"), `expected the prefix "user", got "role"`)
+	assert.NotNil(t, format.validate("user:AdministratorAccess:tf-test"))
+}
+
+func TestUnitCommonImportStateWithIdFormat(t *testing.T) {
+	importer := ImportStateWithIdFormat(
+		ImportIdFormat{
+			Parts: []string{"load_balancer_id", "protocol", "frontend_port"},
+			Patterns: map[string]string{
+				"frontend_port": `^[0-9]+$`,
+			},
+		},
+		importIdFromFormat(ImportIdFormat{
+			Parts: []string{"load_balancer_id", "frontend_port"},
+		}),
+	)
+	id, err := testImportState(importer, " lb-abc123:tcp:80 ")
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc123:tcp:80", id)
+	id, err = testImportState(importer, "lb-abc123:80")
+	assert.Nil(t, err)
+	assert.Equal(t, "lb-abc123:80", id)
+	_, err = testImportState(importer, "lb-abc123")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `invalid import id "lb-abc123": expected 3 parts separated by ":", got 1. Expected <load_balancer_id>:<protocol>:<frontend_port>, or <load_balancer_id>:<frontend_port>.`)
+
+	importer = ImportStateWithIdFormat(
+		ImportIdFormat{
+			Parts: []string{"vpc_id"},
+			Patterns: map[string]string{
+				"vpc_id": `^vpc-[a-z0-9]+$`,
+			},
+		},
+		importIdFromArn("vpc", "vpc"),
+		ImportIdAlternative{
+			Description: "the name of the resource",
+			Resolve: func(client *connectivity.AliyunClient, name string) (string, error) {
+				switch name {
+				case "tf-test":
+					return "vpc-abc123", nil
+				case "tf-duplicated":
+					return "", fmt.Errorf("2 resources are named %q", name)
+				}
+				return "", nil
+			},
+		},
+	)
+	id, err = testImportState(importer, "acs:vpc:cn-hangzhou:123456:vpc/vpc-abc123")
+	assert.Nil(t, err)
+	assert.Equal(t, "vpc-abc123", id)
+	id, err = testImportState(importer, "tf-test")
+	assert.Nil(t, err)
+	assert.Equal(t, "vpc-abc123", id)
+	_, err = testImportState(importer, "tf-duplicated")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "2 resources are named")
+	_, err = testImportState(importer, "acs:ecs:cn-hangzhou:123456:instance/i-abc123")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Expected <vpc_id>, or the ARN acs:vpc:<region>:<account_id>:vpc/<id>, or the name of the resource.")
+}
+
+func TestUnitCommonImportIdFromArn(t *testing.T) {
+	bucket := importIdFromArn("oss", "")
+	id, err := bucket.Resolve(nil, "acs:oss:*:123456:tf-test-bucket")
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-test-bucket", id)
+	id, _ = bucket.Resolve(nil, "acs:oss:*:123456:tf-test-bucket/object")
+	assert.Empty(t, id)
+
+	policy := importIdFromArn("ram", "policy")
+	id, _ = policy.Resolve(nil, "acs:ram::123456:policy/tf-test-policy")
+	assert.Equal(t, "tf-test-policy", id)
+	id, _ = policy.Resolve(nil, "acs:ram::123456:role/tf-test-role")
+	assert.Empty(t, id)
+	id, _ = policy.Resolve(nil, "tf-test-policy")
+	assert.Empty(t, id)
+}
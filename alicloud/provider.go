@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aliyun/credentials-go/credentials"
 	"github.com/aliyun/credentials-go/credentials/providers"
@@ -86,9 +87,35 @@ func Provider() terraform.ResourceProvider {
 				ValidateFunc: StringInSlice([]string{"Domestic", "International"}, true),
 				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"ALIBABA_CLOUD_ACCOUNT_TYPE"}, nil),
 			},
+			"allowed_account_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"forbidden_account_ids"},
+				Description:   descriptions["allowed_account_ids"],
+			},
+			"forbidden_account_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"allowed_account_ids"},
+				Description:   descriptions["forbidden_account_ids"],
+			},
 			"assume_role":           assumeRoleSchema(),
 			"sign_version":          signVersionSchema(),
 			"assume_role_with_oidc": assumeRoleWithOidcSchema(),
+			"default_tags":          defaultTagsSchema(),
+			"ignore_tags":           ignoreTagsSchema(),
+			"api_rate_limits":       apiRateLimitsSchema(),
+			"api_retry_policies":    apiRetryPoliciesSchema(),
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"ALIBABA_CLOUD_ENDPOINT_TYPE"}, connectivity.EndpointTypePublic),
+				ValidateFunc: StringInSlice([]string{connectivity.EndpointTypePublic, connectivity.EndpointTypeVpc, connectivity.EndpointTypeIntranet}, false),
+				Description:  descriptions["endpoint_type"],
+			},
+			"product_endpoint_types": productEndpointTypesSchema(),
 			"fc": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -163,12 +190,24 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_CREDENTIALS_URI", "ALIBABA_CLOUD_CREDENTIALS_URI"}, nil),
 				Description: descriptions["credentials_uri"],
 			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABA_CLOUD_CREDENTIAL_PROCESS", nil),
+				Description: descriptions["credential_process"],
+			},
 			"max_retry_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRY_TIMEOUT", 0),
 				Description: descriptions["max_retry_timeout"],
 			},
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABA_CLOUD_API_TRACE_FILE", ""),
+				Description: descriptions["api_trace_file"],
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"alicloud_express_connect_router_vpc_associations":    dataSourceAliCloudExpressConnectRouterVpcAssociations(),
@@ -263,6 +302,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_ram_roles":                                        dataSourceAliCloudRamRoles(),
 			"alicloud_ram_policies":                                     dataSourceAliCloudRamPolicies(),
 			"alicloud_ram_policy_document":                              dataSourceAliCloudRamPolicyDocument(),
+			"alicloud_ram_policy_simulation":                            dataSourceAliCloudRamPolicySimulation(),
 			"alicloud_security_groups":                                  dataSourceAlicloudSecurityGroups(),
 			"alicloud_security_group_rules":                             dataSourceAlicloudSecurityGroupRules(),
 			"alicloud_slbs":                                             dataSourceAlicloudSlbLoadBalancers(),
@@ -783,6 +823,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_ecs_invocations":                                  dataSourceAlicloudEcsInvocations(),
 			"alicloud_ecd_snapshots":                                    dataSourceAlicloudEcdSnapshots(),
 			"alicloud_tag_meta_tags":                                    dataSourceAlicloudTagMetaTags(),
+			"alicloud_tag_non_compliant_resources":                      dataSourceAliCloudTagNonCompliantResources(),
 			"alicloud_ecd_desktop_types":                                dataSourceAlicloudEcdDesktopTypes(),
 			"alicloud_config_deliveries":                                dataSourceAlicloudConfigDeliveries(),
 			"alicloud_cms_namespaces":                                   dataSourceAlicloudCmsNamespaces(),
@@ -971,6 +1012,8 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_nlb_hd_monitor_region_config":                         resourceAliCloudNlbHdMonitorRegionConfig(),
 			"alicloud_live_domain":                                          resourceAliCloudLiveDomain(),
 			"alicloud_oss_bucket_overwrite_config":                          resourceAliCloudOssBucketOverwriteConfig(),
+			"alicloud_oss_bucket_lifecycle":                                 resourceAliCloudOssBucketLifecycle(),
+			"alicloud_oss_bucket_objects_sync":                              resourceAliCloudOssBucketObjectsSync(),
 			"alicloud_cloud_firewall_user_alarm_config":                     resourceAliCloudCloudFirewallUserAlarmConfig(),
 			"alicloud_oss_bucket_archive_direct_read":                       resourceAliCloudOssBucketArchiveDirectRead(),
 			"alicloud_oss_bucket_response_header":                           resourceAliCloudOssBucketResponseHeader(),
@@ -1608,6 +1651,7 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_resource_manager_policy_version":                       resourceAlicloudResourceManagerPolicyVersion(),
 			"alicloud_kms_key_version":                                       resourceAlicloudKmsKeyVersion(),
 			"alicloud_alidns_record":                                         resourceAliCloudAlidnsRecord(),
+			"alicloud_alidns_record_set":                                     resourceAliCloudAlidnsRecordSet(),
 			"alicloud_ddoscoo_scheduler_rule":                                resourceAlicloudDdoscooSchedulerRule(),
 			"alicloud_cassandra_cluster":                                     resourceAlicloudCassandraCluster(),
 			"alicloud_cassandra_data_center":                                 resourceAlicloudCassandraDataCenter(),
@@ -2101,6 +2145,9 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_polardb_zonal_account":                                 resourceAlicloudPolarDBZonalAccount(),
 		},
 	}
+	for _, r := range provider.ResourcesMap {
+		resourceWithDefaultTags(r)
+	}
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		return providerConfigure(d, provider)
 	}
@@ -2187,12 +2234,16 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		SourceIp:             strings.TrimSpace(d.Get("source_ip").(string)),
 		SecureTransport:      strings.TrimSpace(d.Get("secure_transport").(string)),
 		MaxRetryTimeout:      d.Get("max_retry_timeout").(int),
+		ApiTraceFile:         strings.TrimSpace(d.Get("api_trace_file").(string)),
 		TerraformTraceId:     strings.Trim(uuid.New().String(), "-"),
 		TerraformVersion:     p.TerraformVersion,
 	}
 	if credential != nil {
 		config.Credential = credential
 	}
+	if accessKey == "" || secretKey == "" {
+		config.CredentialProcess = strings.TrimSpace(getProviderConfig("credential_process", "credential_process"))
+	}
 	log.Println("alicloud provider trace id:", config.TerraformTraceId)
 	if accessKey != "" && secretKey != "" && credential == nil {
 		credentialConfig := new(credentials.Config).SetType("access_key").
@@ -2221,6 +2272,16 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 	if v, ok := d.GetOk("account_type"); ok && v.(string) != "" {
 		config.AccountType = v.(string)
 	}
+	if v, ok := d.GetOk("allowed_account_ids"); ok {
+		for _, accountId := range v.(*schema.Set).List() {
+			config.AllowedAccountIds = append(config.AllowedAccountIds, strings.TrimSpace(accountId.(string)))
+		}
+	}
+	if v, ok := d.GetOk("forbidden_account_ids"); ok {
+		for _, accountId := range v.(*schema.Set).List() {
+			config.ForbiddenAccountIds = append(config.ForbiddenAccountIds, strings.TrimSpace(accountId.(string)))
+		}
+	}
 	if v, ok := d.GetOk("security_transport"); config.SecureTransport == "" && ok && v.(string) != "" {
 		config.SecureTransport = v.(string)
 	}
@@ -2232,37 +2293,56 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		config.RamRoleSessionExpiration = (int)(expiredSeconds.(float64))
 	}
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		if assumeRole["role_arn"].(string) != "" {
-			config.RamRoleArn = assumeRole["role_arn"].(string)
+	for i, v := range d.Get("assume_role").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		assumeRole := v.(map[string]interface{})
+		hop := connectivity.AssumeRoleHop{
+			RoleArn:           assumeRole["role_arn"].(string),
+			SessionName:       assumeRole["session_name"].(string),
+			Policy:            assumeRole["policy"].(string),
+			ExternalId:        assumeRole["external_id"].(string),
+			SessionExpiration: assumeRole["session_expiration"].(int),
 		}
-		if assumeRole["session_name"].(string) != "" {
-			config.RamRoleSessionName = assumeRole["session_name"].(string)
+		if i == 0 {
+			// the first role falls back to the one in the profile
+			if hop.RoleArn == "" {
+				hop.RoleArn = config.RamRoleArn
+			}
+			if hop.SessionName == "" {
+				hop.SessionName = config.RamRoleSessionName
+			}
 		}
-		if config.RamRoleSessionName == "" {
-			config.RamRoleSessionName = "terraform"
+		if hop.SessionName == "" {
+			hop.SessionName = "terraform"
 		}
-		config.RamRolePolicy = assumeRole["policy"].(string)
-		if assumeRole["session_expiration"].(int) == 0 {
+		if hop.SessionExpiration == 0 {
+			if i == 0 {
+				hop.SessionExpiration = config.RamRoleSessionExpiration
+			}
 			if v := os.Getenv("ALICLOUD_ASSUME_ROLE_SESSION_EXPIRATION"); v != "" {
 				if expiredSeconds, err := strconv.Atoi(v); err == nil {
-					config.RamRoleSessionExpiration = expiredSeconds
+					hop.SessionExpiration = expiredSeconds
 				}
 			}
-			if config.RamRoleSessionExpiration == 0 {
-				config.RamRoleSessionExpiration = 3600
+			if hop.SessionExpiration == 0 {
+				hop.SessionExpiration = 3600
 			}
-		} else {
-			config.RamRoleSessionExpiration = assumeRole["session_expiration"].(int)
-		}
-		if v := assumeRole["external_id"].(string); v != "" {
-			config.RamRoleExternalId = v
 		}
+		config.AssumeRoleChain = append(config.AssumeRoleChain, hop)
 
-		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d, RamRoleExternalId: %s)",
-			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration, config.RamRoleExternalId)
+		log.Printf("[INFO] assume_role.%d configuration set: (RoleArn: %q, SessionName: %q, Policy: %q, SessionExpiration: %d, ExternalId: %s)",
+			i, hop.RoleArn, hop.SessionName, hop.Policy, hop.SessionExpiration, hop.ExternalId)
+	}
+	if len(config.AssumeRoleChain) > 0 {
+		// the first role is assumed by the provider credential, and the others are assumed in order by the previous role
+		firstRole := config.AssumeRoleChain[0]
+		config.RamRoleArn = firstRole.RoleArn
+		config.RamRoleSessionName = firstRole.SessionName
+		config.RamRolePolicy = firstRole.Policy
+		config.RamRoleSessionExpiration = firstRole.SessionExpiration
+		config.RamRoleExternalId = firstRole.ExternalId
 	}
 
 	if v, ok := d.GetOk("assume_role_with_oidc"); ok && len(v.([]interface{})) == 1 {
@@ -2274,6 +2354,53 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 			config.AssumeRoleWithOidc.RoleARN, config.AssumeRoleWithOidc.RoleSessionName, config.AssumeRoleWithOidc.DurationSeconds, config.AssumeRoleWithOidc.OIDCProviderArn)
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		defaultTags := v.([]interface{})[0].(map[string]interface{})
+		if tags, ok := defaultTags["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+			config.DefaultTags = tags
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		config.IgnoreTagKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+	providerIgnoreTags = newIgnoreTagsConfig(config.IgnoreTagKeys, config.IgnoreTagKeyPrefixes)
+
+	if v, ok := d.GetOk("api_rate_limits"); ok {
+		config.ApiRateLimits = make(map[string]connectivity.ApiRateLimit)
+		for _, raw := range v.(*schema.Set).List() {
+			apiRateLimit := raw.(map[string]interface{})
+			config.ApiRateLimits[apiRateLimit["product"].(string)] = connectivity.ApiRateLimit{
+				RequestsPerSecond: apiRateLimit["requests_per_second"].(float64),
+				Burst:             apiRateLimit["burst"].(int),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("api_retry_policies"); ok {
+		config.RetryPolicies = make(map[string]connectivity.RetryPolicy)
+		for _, raw := range v.(*schema.Set).List() {
+			retryPolicy := raw.(map[string]interface{})
+			config.RetryPolicies[strings.ToLower(retryPolicy["product"].(string))] = connectivity.RetryPolicy{
+				MaxAttempts:     retryPolicy["max_attempts"].(int),
+				MaxElapsedTime:  time.Duration(retryPolicy["max_elapsed_time"].(int)) * time.Second,
+				InitialInterval: time.Duration(retryPolicy["initial_interval"].(int)) * time.Second,
+				MaxInterval:     time.Duration(retryPolicy["max_interval"].(int)) * time.Second,
+			}
+		}
+	}
+
+	config.EndpointType = d.Get("endpoint_type").(string)
+	if v, ok := d.GetOk("product_endpoint_types"); ok {
+		config.ProductEndpointTypes = make(map[string]string)
+		for _, raw := range v.(*schema.Set).List() {
+			productEndpointType := raw.(map[string]interface{})
+			config.ProductEndpointTypes[strings.ToLower(productEndpointType["product"].(string))] = productEndpointType["endpoint_type"].(string)
+		}
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 	var endpointInit sync.Map
 	config.Endpoints = &endpointInit
@@ -2494,6 +2621,11 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		return nil, err
 	}
 
+	// checking the account before any resource operation avoids applying to a wrong account
+	if err := client.ValidateAccountId(); err != nil {
+		return nil, err
+	}
+
 	return client, nil
 }
 
@@ -2513,6 +2645,10 @@ func init() {
 
 		"account_id": "The account ID for some service API operations. You can retrieve this from the 'Security Settings' section of the Alibaba Cloud console.",
 
+		"allowed_account_ids": "The list of allowed Alibaba Cloud account IDs to prevent you from mistakenly using an incorrect one. Conflicts with `forbidden_account_ids`.",
+
+		"forbidden_account_ids": "The list of forbidden Alibaba Cloud account IDs to prevent you from mistakenly using the wrong one. Conflicts with `allowed_account_ids`.",
+
 		"profile": "The profile for API operations. If not set, the default profile created with `aliyun configure` will be used.",
 
 		"shared_credentials_file": "The path to the shared credentials file. If not set this defaults to ~/.aliyun/config.json",
@@ -2534,7 +2670,31 @@ func init() {
 		"source_ip":              "The source ip for the assume role invoking.",
 		"secure_transport":       "The security transport for the assume role invoking.",
 		"credentials_uri":        "The URI of sidecar credentials service.",
+		"credential_process":     "The command which prints the credential as JSON with AccessKeyId, AccessKeySecret, SecurityToken and Expiration. The command is run again before the credential expires.",
 		"max_retry_timeout":      "The maximum retry timeout of the request.",
+		"default_tags":           "Configuration block with resource tag settings to apply across all taggable resources.",
+		"default_tags_tags":      "A group of tags to apply across all taggable resources. The tags defined in the resource `tags` take precedence over them.",
+		"ignore_tags":            "Configuration block with resource tag settings to ignore across all taggable resources.",
+		"ignore_tags_keys":       "A list of exact resource tag keys to ignore across all taggable resources.",
+		"ignore_tags_prefixes":   "A list of resource tag key prefixes to ignore across all taggable resources.",
+
+		"api_trace_file":                      "The path of the file to trace the API calls. Each API call is written as one JSON line with its product, action, region, endpoint, HTTP status, error code, request id, retry count and duration.",
+		"api_rate_limits":                     "The client side rate limits of the product APIs. The requests of a product are paused with a jittered backoff and its rate is reduced once the product responds a throttling error.",
+		"api_rate_limits_product":             "The product code of the API, like ecs, vpc, slb and r_kvstore.",
+		"api_rate_limits_requests_per_second": "The maximum number of requests sent to the product per second.",
+		"api_rate_limits_burst":               "The maximum number of requests sent to the product at once. Defaults to requests_per_second rounded up.",
+
+		"api_retry_policies":                  "The retry policies of the product APIs, which override the retry policy of every request sent to the product.",
+		"api_retry_policies_product":          "The product code of the API, like ecs, vpc, slb and r_kvstore.",
+		"api_retry_policies_max_attempts":     "The maximum number of attempts of a request, including the first one.",
+		"api_retry_policies_max_elapsed_time": "The maximum time in seconds to retry a request. Defaults to max_retry_timeout if it is set, otherwise 300.",
+		"api_retry_policies_initial_interval": "The wait time in seconds before the first retry. The wait time is doubled after each retry with a jitter.",
+		"api_retry_policies_max_interval":     "The maximum wait time in seconds between two retries.",
+
+		"endpoint_type":                        "The type of the endpoints resolved by the provider, public, vpc or intranet. The VPC and intranet endpoints are used by the runners without internet access, and the public endpoint is used if a product has no such endpoint.",
+		"product_endpoint_types":               "The endpoint types of the products, which override endpoint_type.",
+		"product_endpoint_types_product":       "The product code of the API, like ecs, vpc, oss, sls and ots.",
+		"product_endpoint_types_endpoint_type": "The type of the endpoints of the product, public, vpc or intranet.",
 
 		"ecs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ECS endpoints.",
 
@@ -2806,9 +2966,8 @@ func init() {
 // lintignore: S018
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -2898,6 +3057,148 @@ func assumeRoleWithOidcSchema() *schema.Schema {
 	}
 }
 
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+func apiRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: descriptions["api_rate_limits"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  descriptions["api_rate_limits_product"],
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validation.FloatAtLeast(0.01),
+					Description:  descriptions["api_rate_limits_requests_per_second"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_rate_limits_burst"],
+				},
+			},
+		},
+	}
+}
+
+func apiRetryPoliciesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: descriptions["api_retry_policies"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  descriptions["api_retry_policies_product"],
+				},
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_retry_policies_max_attempts"],
+				},
+				"max_elapsed_time": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_retry_policies_max_elapsed_time"],
+				},
+				"initial_interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_retry_policies_initial_interval"],
+				},
+				"max_interval": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["api_retry_policies_max_interval"],
+				},
+			},
+		},
+	}
+}
+
+func productEndpointTypesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: descriptions["product_endpoint_types"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  descriptions["product_endpoint_types_product"],
+				},
+				"endpoint_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: StringInSlice([]string{connectivity.EndpointTypePublic, connectivity.EndpointTypeVpc, connectivity.EndpointTypeIntranet}, false),
+					Description:  descriptions["product_endpoint_types_endpoint_type"],
+				},
+			},
+		},
+	}
+}
+
 // lintignore: S018
 func signVersionSchema() *schema.Schema {
 	return &schema.Schema{
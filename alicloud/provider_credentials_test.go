@@ -423,6 +423,51 @@ func TestProviderCredentials_GetConfigFromProfile(t *testing.T) {
 	}
 }
 
+// TestProviderCredentials_CredentialProcessFromProfile 测试从 Profile 读取 credential_process
+func TestProviderCredentials_CredentialProcessFromProfile(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "alicloud-test-credential-process-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	configPath := filepath.Join(tmpDir, "config.json")
+	config := map[string]interface{}{
+		"profiles": []map[string]interface{}{
+			{
+				"name":               "test-broker",
+				"mode":               "AK",
+				"credential_process": "secrets-broker sts --format json",
+				"region_id":          "cn-shanghai",
+			},
+		},
+		"current": "test-broker",
+	}
+	configData, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(configPath, configData, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	raw := map[string]interface{}{
+		"profile":                 "test-broker",
+		"shared_credentials_file": configPath,
+	}
+	resourceData := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, raw)
+
+	providerConfig = nil // 重置
+	val, err := getConfigFromProfile(resourceData, "credential_process")
+	if err != nil {
+		t.Fatalf("Failed to get config: %v", err)
+	}
+	if val != "secrets-broker sts --format json" {
+		t.Errorf("Expected the credential_process of the profile, got %v", val)
+	}
+	providerConfig = nil
+}
+
 // TestProviderCredentials_AdvancedModesReturnNil 测试高级模式返回 nil
 func TestProviderCredentials_AdvancedModesReturnNil(t *testing.T) {
 	tmpDir, err := ioutil.TempDir("", "alicloud-test-advanced-*")
@@ -1113,7 +1158,7 @@ func TestProviderConfigure_AssumeRoleConfig(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, provider.Schema, raw)
 
 	// 只测试配置能够正确解析，不测试实际的 STS 调用
-	assumeRoleList := resourceData.Get("assume_role").(*schema.Set).List()
+	assumeRoleList := resourceData.Get("assume_role").([]interface{})
 	if len(assumeRoleList) != 1 {
 		t.Fatalf("Expected 1 assume_role config, got %d", len(assumeRoleList))
 	}
@@ -1151,7 +1196,7 @@ func TestProviderConfigure_AssumeRoleDefaultSessionName(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, provider.Schema, raw)
 
 	// 测试配置解析，验证 session_name 的默认值逻辑
-	assumeRoleList := resourceData.Get("assume_role").(*schema.Set).List()
+	assumeRoleList := resourceData.Get("assume_role").([]interface{})
 	if len(assumeRoleList) != 1 {
 		t.Fatalf("Expected 1 assume_role config, got %d", len(assumeRoleList))
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"math/rand"
 	"net/http"
 	"os"
 	"strings"
@@ -63,6 +64,29 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccRecorder starts the recorder of the API requests for the test if the env ALICLOUD_RECORDER_MODE is set, and
+// returns a random generator which generates the same resource names in the record and replay. In the replay mode, the
+// fake credentials are used if they are not set, so the test can run without a live account.
+func testAccRecorder(t *testing.T) *rand.Rand {
+	recorder, err := connectivity.StartRecorder(t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := recorder.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	if recorder.Mode() == connectivity.RecorderModeReplay {
+		for _, key := range []string{"ALICLOUD_ACCESS_KEY", "ALICLOUD_SECRET_KEY"} {
+			if os.Getenv(key) == "" {
+				t.Setenv(key, "replay")
+			}
+		}
+	}
+	return recorder.Rand()
+}
+
 func testAccPreCheckForCleanUpInstances(t *testing.T, instanceRegion, productCode, productType, productCodeIntl, productTypeIntl string) {
 	rawClient, err := sharedClientForRegion(defaultRegionToTest)
 	if err != nil {
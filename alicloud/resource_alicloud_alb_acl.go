@@ -158,7 +158,7 @@ func resourceAlicloudAlbAclUpdate(d *schema.ResourceData, meta interface{}) erro
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := albService.SetResourceTags(d, "acl"); err != nil {
 			return WrapError(err)
 		}
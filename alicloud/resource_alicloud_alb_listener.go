@@ -19,9 +19,15 @@ func resourceAliCloudAlbListener() *schema.Resource {
 		Read:   resourceAliCloudAlbListenerRead,
 		Update: resourceAliCloudAlbListenerUpdate,
 		Delete: resourceAliCloudAlbListenerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"listener_id"},
+				Patterns: map[string]string{
+					"listener_id": `^lsn-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("alb", "listener"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -1280,7 +1286,7 @@ func resourceAliCloudAlbListenerUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		albServiceV2 := AlbServiceV2{client}
 		if err := albServiceV2.SetResourceTags(d, "listener"); err != nil {
 			return WrapError(err)
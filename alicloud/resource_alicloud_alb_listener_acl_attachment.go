@@ -17,9 +17,14 @@ func resourceAliCloudAlbListenerAclAttachment() *schema.Resource {
 		Create: resourceAliCloudAlbListenerAclAttachmentCreate,
 		Read:   resourceAliCloudAlbListenerAclAttachmentRead,
 		Delete: resourceAliCloudAlbListenerAclAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"listener_id", "acl_id"},
+				Patterns: map[string]string{
+					"listener_id": `^lsn-[a-z0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -15,9 +15,14 @@ func resourceAlicloudAlbListenerAdditionalCertificateAttachment() *schema.Resour
 		Create: resourceAlicloudAlbListenerAdditionalCertificateAttachmentCreate,
 		Read:   resourceAlicloudAlbListenerAdditionalCertificateAttachmentRead,
 		Delete: resourceAlicloudAlbListenerAdditionalCertificateAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"listener_id", "certificate_id"},
+				Patterns: map[string]string{
+					"listener_id": `^lsn-[a-z0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -18,9 +18,16 @@ func resourceAliCloudAlbLoadBalancer() *schema.Resource {
 		Read:   resourceAliCloudAlbLoadBalancerRead,
 		Update: resourceAliCloudAlbLoadBalancerUpdate,
 		Delete: resourceAliCloudAlbLoadBalancerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"load_balancer_id"},
+				Patterns: map[string]string{
+					"load_balancer_id": `^alb-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("alb", "loadbalancer"),
+			importIdByName("Alb", "2020-06-16", "ListLoadBalancers", "LoadBalancerNames.1", "$.LoadBalancers[*]", "LoadBalancerName", "LoadBalancerId", nil),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -911,7 +918,7 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		albServiceV2 := AlbServiceV2{client}
 		if err := albServiceV2.SetResourceTags(d, "loadbalancer"); err != nil {
 			return WrapError(err)
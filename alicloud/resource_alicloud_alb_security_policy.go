@@ -122,7 +122,7 @@ func resourceAlicloudAlbSecurityPolicyUpdate(d *schema.ResourceData, meta interf
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := albService.SetResourceTags(d, "securitypolicy"); err != nil {
 			return WrapError(err)
 		}
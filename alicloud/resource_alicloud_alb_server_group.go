@@ -18,9 +18,15 @@ func resourceAliCloudAlbServerGroup() *schema.Resource {
 		Update:        resourceAliCloudAlbServerGroupUpdate,
 		Delete:        resourceAliCloudAlbServerGroupDelete,
 		CustomizeDiff: resourceAlbServerGroupCustomizeDiff,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"server_group_id"},
+				Patterns: map[string]string{
+					"server_group_id": `^sgp-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("alb", "servergroup"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -1032,7 +1038,7 @@ func resourceAliCloudAlbServerGroupUpdate(d *schema.ResourceData, meta interface
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		albServiceV2 := AlbServiceV2{client}
 		if err := albServiceV2.SetResourceTags(d, "servergroup"); err != nil {
 			return WrapError(err)
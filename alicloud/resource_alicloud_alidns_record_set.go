@@ -0,0 +1,545 @@
+package alicloud
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAliCloudAlidnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudAlidnsRecordSetCreate,
+		Read:   resourceAliCloudAlidnsRecordSetRead,
+		Update: resourceAliCloudAlidnsRecordSetUpdate,
+		Delete: resourceAliCloudAlidnsRecordSetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		CustomizeDiff: ConflictsWhenValue("weight_enabled", "type", "NS", "MX", "TXT", "SRV", "CAA", "REDIRECT_URL", "FORWARD_URL"),
+		Schema: map[string]*schema.Schema{
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"line": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "default",
+			},
+			"ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  600,
+			},
+			"weight_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      alidnsRecordSetRecordHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: dnsValueDiffSuppressFunc,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: IntBetween(1, 100),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: IntBetween(0, 50),
+						},
+					},
+				},
+			},
+			"record_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAliCloudAlidnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	alidnsService := AlidnsService{client}
+
+	id := fmt.Sprintf("%v:%v:%v:%v", d.Get("domain_name"), d.Get("rr"), d.Get("type"), d.Get("line"))
+	if !d.Get("allow_overwrite").(bool) {
+		objects, err := alidnsService.DescribeAlidnsRecordSet(id)
+		if err != nil && !NotFoundError(err) {
+			return WrapError(err)
+		}
+		if len(objects) > 0 {
+			return WrapError(fmt.Errorf("there are %d records of %s already, please import them by the id %s or set allow_overwrite to true", len(objects), id, id))
+		}
+	}
+
+	d.SetId(id)
+	if err := alidnsRecordSetConverge(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+	return resourceAliCloudAlidnsRecordSetRead(d, meta)
+}
+
+func resourceAliCloudAlidnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	alidnsService := AlidnsService{client}
+	objects, err := alidnsService.DescribeAlidnsRecordSet(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_alidns_record_set alidnsService.DescribeAlidnsRecordSet Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	weightEnabled, err := alidnsService.DescribeAlidnsRecordSetWeightEnabled(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("domain_name", parts[0])
+	d.Set("rr", parts[1])
+	d.Set("type", parts[2])
+	d.Set("line", parts[3])
+	d.Set("weight_enabled", weightEnabled)
+
+	// The weights are only returned when the weighted round-robin is enabled, otherwise the ones in the state are kept.
+	weights := make(map[string]int)
+	if v, ok := d.GetOk("records"); ok {
+		for _, raw := range v.(*schema.Set).List() {
+			record := raw.(map[string]interface{})
+			weights[alidnsRecordSetNormalizeValue(parts[2], record["value"].(string))] = record["weight"].(int)
+		}
+	}
+
+	// All of the records of the name, type and line are read, so the ones added out of band show up as a diff.
+	ttl := d.Get("ttl").(int)
+	records := make([]interface{}, 0, len(objects))
+	recordIds := make(map[string]interface{}, len(objects))
+	for _, object := range objects {
+		if int(object.TTL) != ttl {
+			ttl = int(object.TTL)
+		}
+		record := alidnsRecordSetRecordFromObject(parts[2], object, weightEnabled, weights)
+		records = append(records, map[string]interface{}{
+			"value":    record.Value,
+			"weight":   record.Weight,
+			"priority": record.Priority,
+		})
+		recordIds[record.Value] = record.RecordId
+	}
+	d.Set("ttl", ttl)
+	if err := d.Set("records", schema.NewSet(alidnsRecordSetRecordHash, records)); err != nil {
+		return WrapError(err)
+	}
+	d.Set("record_ids", recordIds)
+
+	return nil
+}
+
+func resourceAliCloudAlidnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("records", "ttl", "weight_enabled") {
+		if err := alidnsRecordSetConverge(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+	return resourceAliCloudAlidnsRecordSetRead(d, meta)
+}
+
+func resourceAliCloudAlidnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	alidnsService := AlidnsService{client}
+	objects, err := alidnsService.DescribeAlidnsRecordSet(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	for _, object := range objects {
+		if err := alidnsRecordSetDeleteRecord(client, object.RecordId, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// alidnsRecordSetRecord is a record of the set. Priority is only used by the MX records.
+type alidnsRecordSetRecord struct {
+	RecordId string
+	Value    string
+	Weight   int
+	Priority int
+	TTL      int
+}
+
+// alidnsRecordSetConverge adds, updates and deletes the records one by one until they are the same as the configured
+// ones, and then applies the weighted round-robin switch and the weights.
+func alidnsRecordSetConverge(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*connectivity.AliyunClient)
+	alidnsService := AlidnsService{client}
+	recordType := d.Get("type").(string)
+	ttl := d.Get("ttl").(int)
+	weightEnabled := d.Get("weight_enabled").(bool)
+
+	desired := make([]alidnsRecordSetRecord, 0)
+	for _, raw := range d.Get("records").(*schema.Set).List() {
+		record := raw.(map[string]interface{})
+		if recordType == "MX" && record["priority"].(int) < 1 {
+			return WrapError(fmt.Errorf("the priority of the MX record %s is required", record["value"]))
+		}
+		desired = append(desired, alidnsRecordSetRecord{
+			Value:    record["value"].(string),
+			Weight:   record["weight"].(int),
+			Priority: record["priority"].(int),
+		})
+	}
+
+	existing, err := alidnsRecordSetDescribe(alidnsService, d.Id(), recordType)
+	if err != nil {
+		return err
+	}
+	currentWeightEnabled, err := alidnsService.DescribeAlidnsRecordSetWeightEnabled(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+
+	// the weighted round-robin requires at least two records, so it is disabled before the records are removed
+	if currentWeightEnabled && !weightEnabled {
+		if err := alidnsRecordSetSetWeightStatus(client, d.Id(), false); err != nil {
+			return err
+		}
+	}
+
+	adds, updates, deletes := alidnsRecordSetPlan(recordType, ttl, desired, existing)
+	log.Printf("[INFO] Converging alicloud_alidns_record_set %s: %d records to add, %d records to update, %d records to delete.", d.Id(), len(adds), len(updates), len(deletes))
+	// the records are added before the stale ones are deleted, so the name keeps resolving during the change
+	for _, record := range adds {
+		if err := alidnsRecordSetAddRecord(client, d.Id(), record, timeout); err != nil {
+			return err
+		}
+	}
+	for _, record := range updates {
+		if err := alidnsRecordSetUpdateRecord(client, d.Id(), record, timeout); err != nil {
+			return err
+		}
+	}
+	for _, record := range deletes {
+		if err := alidnsRecordSetDeleteRecord(client, record.RecordId, timeout); err != nil {
+			return err
+		}
+	}
+
+	if !weightEnabled {
+		return nil
+	}
+	if !currentWeightEnabled {
+		if err := alidnsRecordSetSetWeightStatus(client, d.Id(), true); err != nil {
+			return err
+		}
+	}
+	existing, err = alidnsRecordSetDescribe(alidnsService, d.Id(), recordType)
+	if err != nil {
+		return err
+	}
+	for _, record := range alidnsRecordSetWeightChanges(recordType, desired, existing) {
+		if err := alidnsRecordSetUpdateWeight(client, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func alidnsRecordSetDescribe(alidnsService AlidnsService, id, recordType string) ([]alidnsRecordSetRecord, error) {
+	objects, err := alidnsService.DescribeAlidnsRecordSet(id)
+	if err != nil && !NotFoundError(err) {
+		return nil, WrapError(err)
+	}
+	records := make([]alidnsRecordSetRecord, 0, len(objects))
+	for _, object := range objects {
+		records = append(records, alidnsRecordSetRecordFromObject(recordType, object, true, nil))
+	}
+	return records, nil
+}
+
+// alidnsRecordSetPlan matches the existing records to the desired ones by their values, and returns the records to
+// add, the existing records to update with their new ttl or priority, and the existing records to delete.
+func alidnsRecordSetPlan(recordType string, ttl int, desired, existing []alidnsRecordSetRecord) (adds, updates, deletes []alidnsRecordSetRecord) {
+	current := make(map[string]alidnsRecordSetRecord, len(existing))
+	for _, record := range existing {
+		current[alidnsRecordSetNormalizeValue(recordType, record.Value)] = record
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, record := range desired {
+		key := alidnsRecordSetNormalizeValue(recordType, record.Value)
+		wanted[key] = true
+		record.TTL = ttl
+		old, ok := current[key]
+		if !ok {
+			adds = append(adds, record)
+			continue
+		}
+		if old.TTL != ttl || (recordType == "MX" && old.Priority != record.Priority) {
+			record.RecordId = old.RecordId
+			updates = append(updates, record)
+		}
+	}
+	for _, record := range existing {
+		if !wanted[alidnsRecordSetNormalizeValue(recordType, record.Value)] {
+			deletes = append(deletes, record)
+		}
+	}
+	sort.Slice(adds, func(i, j int) bool { return adds[i].Value < adds[j].Value })
+	sort.Slice(updates, func(i, j int) bool { return updates[i].Value < updates[j].Value })
+	sort.Slice(deletes, func(i, j int) bool { return deletes[i].Value < deletes[j].Value })
+	return adds, updates, deletes
+}
+
+// alidnsRecordSetWeightChanges returns the existing records whose weights are different from the desired ones, with
+// the desired weights.
+func alidnsRecordSetWeightChanges(recordType string, desired, existing []alidnsRecordSetRecord) []alidnsRecordSetRecord {
+	weights := make(map[string]int, len(desired))
+	for _, record := range desired {
+		weights[alidnsRecordSetNormalizeValue(recordType, record.Value)] = record.Weight
+	}
+	changes := make([]alidnsRecordSetRecord, 0)
+	for _, record := range existing {
+		weight, ok := weights[alidnsRecordSetNormalizeValue(recordType, record.Value)]
+		if ok && weight != record.Weight {
+			record.Weight = weight
+			changes = append(changes, record)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Value < changes[j].Value })
+	return changes
+}
+
+// alidnsRecordSetRecordFromObject converts the record returned by the API. When the weighted round-robin is disabled,
+// the weight is taken from weights by the value, and defaults to 1.
+func alidnsRecordSetRecordFromObject(recordType string, object alidns.Record, weightEnabled bool, weights map[string]int) alidnsRecordSetRecord {
+	record := alidnsRecordSetRecord{
+		RecordId: object.RecordId,
+		Value:    object.Value,
+		Weight:   object.Weight,
+		TTL:      int(object.TTL),
+	}
+	if recordType == "MX" {
+		record.Priority = int(object.Priority)
+	}
+	if !weightEnabled {
+		record.Weight = 1
+		if weight, ok := weights[alidnsRecordSetNormalizeValue(recordType, object.Value)]; ok {
+			record.Weight = weight
+		}
+	}
+	return record
+}
+
+// alidnsRecordSetNormalizeValue drops the trailing dot of the domain names, which is not returned by the API.
+func alidnsRecordSetNormalizeValue(recordType, value string) string {
+	switch recordType {
+	case "NS", "MX", "CNAME", "SRV":
+		return strings.TrimSuffix(strings.TrimSpace(value), ".")
+	}
+	return value
+}
+
+// alidnsRecordSetRecordHash ignores the trailing dot of the value, so the domain names configured with it do not show up
+// as a diff.
+func alidnsRecordSetRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	if v, ok := m["value"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", strings.TrimSuffix(strings.TrimSpace(v.(string)), ".")))
+	}
+	if v, ok := m["weight"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	if v, ok := m["priority"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+	return helper.Hashcode(buf.String())
+}
+
+func alidnsRecordSetAddRecord(client *connectivity.AliyunClient, id string, record alidnsRecordSetRecord, timeout time.Duration) error {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return WrapError(err)
+	}
+	request := alidns.CreateAddDomainRecordRequest()
+	request.DomainName = parts[0]
+	request.RR = parts[1]
+	request.Type = parts[2]
+	request.Line = parts[3]
+	request.Value = record.Value
+	request.TTL = requests.NewInteger(record.TTL)
+	if parts[2] == "MX" {
+		request.Priority = requests.NewInteger(record.Priority)
+	}
+	wait := incrementalWait(3*time.Second, 10*time.Second)
+	err = resource.Retry(client.GetRetryTimeout(timeout), func() *resource.RetryError {
+		raw, err := client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+			return alidnsClient.AddDomainRecord(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalError", "LastOperationNotFinished"}) || NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+func alidnsRecordSetUpdateRecord(client *connectivity.AliyunClient, id string, record alidnsRecordSetRecord, timeout time.Duration) error {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return WrapError(err)
+	}
+	request := alidns.CreateUpdateDomainRecordRequest()
+	request.RecordId = record.RecordId
+	request.RR = parts[1]
+	request.Type = parts[2]
+	request.Line = parts[3]
+	request.Value = record.Value
+	request.TTL = requests.NewInteger(record.TTL)
+	if parts[2] == "MX" {
+		request.Priority = requests.NewInteger(record.Priority)
+	}
+	wait := incrementalWait(3*time.Second, 10*time.Second)
+	err = resource.Retry(client.GetRetryTimeout(timeout), func() *resource.RetryError {
+		raw, err := client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+			return alidnsClient.UpdateDomainRecord(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"LastOperationNotFinished"}) || NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+func alidnsRecordSetDeleteRecord(client *connectivity.AliyunClient, recordId string, timeout time.Duration) error {
+	request := alidns.CreateDeleteDomainRecordRequest()
+	request.RecordId = recordId
+	wait := incrementalWait(3*time.Second, 10*time.Second)
+	err := resource.Retry(client.GetRetryTimeout(timeout), func() *resource.RetryError {
+		raw, err := client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+			return alidnsClient.DeleteDomainRecord(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalError", "RecordForbidden.DNSChange", "LastOperationNotFinished"}) || NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"DomainRecordNotBelongToUser"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, recordId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+func alidnsRecordSetSetWeightStatus(client *connectivity.AliyunClient, id string, open bool) error {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return WrapError(err)
+	}
+	request := alidns.CreateSetDNSSLBStatusRequest()
+	request.DomainName = parts[0]
+	request.SubDomain = parts[1] + "." + parts[0]
+	request.Type = parts[2]
+	request.Line = parts[3]
+	request.Open = requests.NewBoolean(open)
+	raw, err := client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+		return alidnsClient.SetDNSSLBStatus(request)
+	})
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+func alidnsRecordSetUpdateWeight(client *connectivity.AliyunClient, record alidnsRecordSetRecord) error {
+	request := alidns.CreateUpdateDNSSLBWeightRequest()
+	request.RecordId = record.RecordId
+	request.Weight = requests.NewInteger(record.Weight)
+	raw, err := client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+		return alidnsClient.UpdateDNSSLBWeight(request)
+	})
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, record.RecordId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
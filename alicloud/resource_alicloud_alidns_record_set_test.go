@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudAlidnsRecordSet_basic(t *testing.T) {
+	var v []alidns.Record
+
+	resourceId := "alicloud_alidns_record_set.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+
+	serviceFunc := func() interface{} {
+		return &AlidnsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandInt()
+	name := fmt.Sprintf("tf-testacc%salidnsrecordset%v.abc", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceAlidnsRecordConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"domain_name": "${alicloud_dns.default.name}",
+					"rr":          "www",
+					"type":        "A",
+					"records": []map[string]interface{}{
+						{"value": "192.0.2.1"},
+						{"value": "192.0.2.2"},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"domain_name":    name,
+						"rr":             "www",
+						"type":           "A",
+						"line":           "default",
+						"ttl":            "600",
+						"weight_enabled": "false",
+						"records.#":      "2",
+						"record_ids.%":   "2",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"ttl":            "900",
+					"weight_enabled": "true",
+					"records": []map[string]interface{}{
+						{"value": "192.0.2.1", "weight": "10"},
+						{"value": "192.0.2.3", "weight": "30"},
+						{"value": "192.0.2.4", "weight": "60"},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"ttl":            "900",
+						"weight_enabled": "true",
+						"records.#":      "3",
+						"record_ids.%":   "3",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"weight_enabled": "false",
+					"records": []map[string]interface{}{
+						{"value": "192.0.2.5"},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"weight_enabled": "false",
+						"records.#":      "1",
+						"record_ids.%":   "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAliCloudAlidnsRecordSetPlan(t *testing.T) {
+	existing := []alidnsRecordSetRecord{
+		{RecordId: "1", Value: "mx1.example.com", Priority: 10, TTL: 600},
+		{RecordId: "2", Value: "mx2.example.com", Priority: 20, TTL: 600},
+		{RecordId: "3", Value: "unmanaged.example.com", Priority: 30, TTL: 600},
+	}
+	desired := []alidnsRecordSetRecord{
+		{Value: "mx1.example.com.", Priority: 10},
+		{Value: "mx2.example.com", Priority: 5},
+		{Value: "mx3.example.com", Priority: 30},
+	}
+	adds, updates, deletes := alidnsRecordSetPlan("MX", 600, desired, existing)
+	assert.Equal(t, []alidnsRecordSetRecord{{Value: "mx3.example.com", Priority: 30, TTL: 600}}, adds)
+	assert.Equal(t, []alidnsRecordSetRecord{{RecordId: "2", Value: "mx2.example.com", Priority: 5, TTL: 600}}, updates)
+	assert.Equal(t, []alidnsRecordSetRecord{existing[2]}, deletes)
+
+	// all of the records are updated once the ttl changes, and the priority is ignored by the other types
+	existing = []alidnsRecordSetRecord{
+		{RecordId: "1", Value: "192.0.2.1", TTL: 600},
+		{RecordId: "2", Value: "192.0.2.2", TTL: 600},
+	}
+	desired = []alidnsRecordSetRecord{
+		{Value: "192.0.2.2", Priority: 1},
+		{Value: "192.0.2.1"},
+	}
+	adds, updates, deletes = alidnsRecordSetPlan("A", 60, desired, existing)
+	assert.Len(t, adds, 0)
+	assert.Len(t, deletes, 0)
+	assert.Equal(t, []alidnsRecordSetRecord{
+		{RecordId: "1", Value: "192.0.2.1", TTL: 60},
+		{RecordId: "2", Value: "192.0.2.2", Priority: 1, TTL: 60},
+	}, updates)
+	adds, updates, deletes = alidnsRecordSetPlan("A", 600, desired, existing)
+	assert.Len(t, adds, 0)
+	assert.Len(t, updates, 0)
+	assert.Len(t, deletes, 0)
+}
+
+func TestUnitAliCloudAlidnsRecordSetWeights(t *testing.T) {
+	existing := []alidnsRecordSetRecord{
+		{RecordId: "1", Value: "192.0.2.1", Weight: 1},
+		{RecordId: "2", Value: "192.0.2.2", Weight: 50},
+		{RecordId: "3", Value: "192.0.2.3", Weight: 1},
+	}
+	desired := []alidnsRecordSetRecord{
+		{Value: "192.0.2.1", Weight: 20},
+		{Value: "192.0.2.2", Weight: 50},
+	}
+	assert.Equal(t, []alidnsRecordSetRecord{{RecordId: "1", Value: "192.0.2.1", Weight: 20}}, alidnsRecordSetWeightChanges("A", desired, existing))
+
+	object := alidns.Record{RecordId: "1", Value: "www.example.com", Weight: 0, TTL: 600, Priority: 10}
+	record := alidnsRecordSetRecordFromObject("CNAME", object, false, map[string]int{"www.example.com": 30})
+	assert.Equal(t, alidnsRecordSetRecord{RecordId: "1", Value: "www.example.com", Weight: 30, TTL: 600}, record)
+	record = alidnsRecordSetRecordFromObject("CNAME", object, false, nil)
+	assert.Equal(t, 1, record.Weight)
+	object.Weight = 5
+	record = alidnsRecordSetRecordFromObject("CNAME", object, true, map[string]int{"www.example.com": 30})
+	assert.Equal(t, 5, record.Weight)
+
+	assert.Equal(t,
+		alidnsRecordSetRecordHash(map[string]interface{}{"value": "www.example.com.", "weight": 1, "priority": 0}),
+		alidnsRecordSetRecordHash(map[string]interface{}{"value": "www.example.com", "weight": 1, "priority": 0}))
+	assert.NotEqual(t,
+		alidnsRecordSetRecordHash(map[string]interface{}{"value": "www.example.com", "weight": 2, "priority": 0}),
+		alidnsRecordSetRecordHash(map[string]interface{}{"value": "www.example.com", "weight": 1, "priority": 0}))
+}
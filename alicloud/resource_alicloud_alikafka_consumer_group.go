@@ -145,7 +145,7 @@ func resourceAliCloudAliKafkaConsumerGroupRead(d *schema.ResourceData, meta inte
 func resourceAliCloudAliKafkaConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		alikafkaServiceV2 := AlikafkaServiceV2{client}
 		if err := alikafkaServiceV2.SetResourceTags(d, "CONSUMERGROUP"); err != nil {
 			return WrapError(err)
@@ -289,7 +289,7 @@ func resourceAliCloudAlikafkaTopicUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		alikafkaServiceV2 := AlikafkaServiceV2{client}
 		if err := alikafkaServiceV2.SetResourceTags(d, "TOPIC"); err != nil {
 			return WrapError(err)
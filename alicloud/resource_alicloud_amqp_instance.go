@@ -644,7 +644,7 @@ func resourceAliCloudAmqpInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		amqpServiceV2 := AmqpServiceV2{client}
 		if err := amqpServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
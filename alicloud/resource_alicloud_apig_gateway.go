@@ -462,7 +462,7 @@ func resourceAliCloudApigGatewayUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		apigServiceV2 := ApigServiceV2{client}
 		if err := apigServiceV2.SetResourceTags(d, "gateway"); err != nil {
 			return WrapError(err)
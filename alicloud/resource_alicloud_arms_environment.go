@@ -281,7 +281,7 @@ func resourceAliCloudArmsEnvironmentUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		armsServiceV2 := ArmsServiceV2{client}
 		if err := armsServiceV2.SetResourceTags(d, "environment"); err != nil {
 			return WrapError(err)
@@ -305,7 +305,7 @@ func resourceAliCloudArmsGrafanaWorkspaceUpdate(d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		armsServiceV2 := ArmsServiceV2{client}
 		if err := armsServiceV2.SetResourceTags(d, "grafanaworkspace"); err != nil {
 			return WrapError(err)
@@ -179,7 +179,7 @@ func resourceAliCloudArmsPrometheusUpdate(d *schema.ResourceData, meta interface
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := armsService.SetResourceTags(d, "PROMETHEUS"); err != nil {
 			return WrapError(err)
 		}
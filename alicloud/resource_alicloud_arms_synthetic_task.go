@@ -1901,7 +1901,7 @@ func resourceAliCloudArmsSyntheticTaskUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		armsServiceV2 := ArmsServiceV2{client}
 		if err := armsServiceV2.SetResourceTags(d, "SYNTHETICTASK"); err != nil {
 			return WrapError(err)
@@ -504,7 +504,7 @@ func resourceAliCloudBastionhostInstanceUpdate(d *schema.ResourceData, meta inte
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := bastionhostService.setInstanceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
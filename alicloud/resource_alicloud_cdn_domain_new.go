@@ -576,7 +576,7 @@ func resourceAliCloudCdnDomainUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		cdnServiceV2 := CdnServiceV2{client}
 		if err := cdnServiceV2.SetResourceTags(d, "DOMAIN"); err != nil {
 			return WrapError(err)
@@ -338,7 +338,7 @@ func resourceAliCloudCenFlowLogUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "flowlog"); err != nil {
 			return WrapError(err)
@@ -241,7 +241,7 @@ func resourceAliCloudCenCenInstanceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "cen"); err != nil {
 			return WrapError(err)
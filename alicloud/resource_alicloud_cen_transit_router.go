@@ -217,7 +217,7 @@ func resourceAliCloudCenTransitRouterUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TransitRouter"); err != nil {
 			return WrapError(err)
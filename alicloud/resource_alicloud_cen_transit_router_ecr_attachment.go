@@ -206,7 +206,7 @@ func resourceAliCloudCenTransitRouterEcrAttachmentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "TRANSITROUTERECRATTACHMENT"); err != nil {
 			return WrapError(err)
@@ -236,7 +236,7 @@ func resourceAliCloudCenTransitRouterMulticastDomainUpdate(d *schema.ResourceDat
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "TRANSITROUTERMULTICASTDOMAIN"); err != nil {
 			return WrapError(err)
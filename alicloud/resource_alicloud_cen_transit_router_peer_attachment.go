@@ -331,7 +331,7 @@ func resourceAliCloudCenTransitRouterPeerAttachmentUpdate(d *schema.ResourceData
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TRANSITROUTERPEERATTACHMENT"); err != nil {
 			return WrapError(err)
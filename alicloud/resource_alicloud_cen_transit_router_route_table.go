@@ -157,7 +157,7 @@ func resourceAlicloudCenTransitRouterRouteTableUpdate(d *schema.ResourceData, me
 		"TransitRouterRouteTableId": parts[1],
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := cbnService.SetResourceTags(d, "TransitRouterRouteTable"); err != nil {
 			return WrapError(err)
 		}
@@ -290,7 +290,7 @@ func resourceAliCloudCenTransitRouterVbrAttachmentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TransitRouterVbrAttachment"); err != nil {
 			return WrapError(err)
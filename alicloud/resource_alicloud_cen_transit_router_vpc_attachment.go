@@ -518,7 +518,7 @@ func resourceAliCloudCenTransitRouterVpcAttachmentUpdate(d *schema.ResourceData,
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cbnService := CbnService{client}
 		if err := cbnService.SetResourceTags(d, "TRANSITROUTERVPCATTACHMENT"); err != nil {
 			return WrapError(err)
@@ -305,7 +305,7 @@ func resourceAliCloudCenTransitRouterVpnAttachmentUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cenServiceV2 := CenServiceV2{client}
 		if err := cenServiceV2.SetResourceTags(d, "TRANSITROUTERVPNATTACHMENT"); err != nil {
 			return WrapError(err)
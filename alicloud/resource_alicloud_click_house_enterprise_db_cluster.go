@@ -542,7 +542,7 @@ func resourceAliCloudClickHouseEnterpriseDbClusterUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		clickHouseServiceV2 := ClickHouseServiceV2{client}
 		if err := clickHouseServiceV2.SetResourceTags(d, "EnterpriseDBCluster"); err != nil {
 			return WrapError(err)
@@ -339,7 +339,7 @@ func resourceAliCloudCloudSsoUserUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		cloudSSOServiceV2 := CloudSSOServiceV2{client}
 		if err := cloudSSOServiceV2.SetResourceTags(d, "user"); err != nil {
 			return WrapError(err)
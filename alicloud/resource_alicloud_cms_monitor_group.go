@@ -173,7 +173,7 @@ func resourceAlicloudCmsMonitorGroupUpdate(d *schema.ResourceData, meta interfac
 		d.SetPartial("contact_groups")
 		d.SetPartial("monitor_group_name")
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := cmsService.SetResourceTags(d, ""); err != nil {
 			return WrapError(err)
 		}
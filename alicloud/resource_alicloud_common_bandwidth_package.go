@@ -373,7 +373,7 @@ func resourceAliCloudCbwpCommonBandwidthPackageUpdate(d *schema.ResourceData, me
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		cbwpServiceV2 := CbwpServiceV2{client}
 		if err := cbwpServiceV2.SetResourceTags(d, "COMMONBANDWIDTHPACKAGE"); err != nil {
 			return WrapError(err)
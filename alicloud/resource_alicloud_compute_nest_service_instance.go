@@ -347,7 +347,7 @@ func resourceAlicloudComputeNestServiceInstanceUpdate(d *schema.ResourceData, me
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := computeNestService.SetResourceTags(d, "serviceinstance"); err != nil {
 			return WrapError(err)
 		}
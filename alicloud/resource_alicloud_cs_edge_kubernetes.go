@@ -576,7 +576,7 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 
 			}
 
-			if d.HasChange("tags") && !d.IsNewResource() {
+			if hasTagsChange(d) && !d.IsNewResource() {
 				if tags, err := ConvertCsTags(d); err == nil {
 					args.Tags = tags
 				}
@@ -670,7 +670,7 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
@@ -898,7 +898,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
@@ -16,6 +16,7 @@ import (
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -26,6 +27,11 @@ func resourceAliCloudAckNodepool() *schema.Resource {
 		Read:   resourceAliCloudAckNodepoolRead,
 		Update: resourceAliCloudAckNodepoolUpdate,
 		Delete: resourceAliCloudAckNodepoolDelete,
+		CustomizeDiff: customdiff.All(
+			ConflictsWhenValue("period", "instance_charge_type", "PostPaid"),
+			RequiredWhen("spot_price_limit", "spot_strategy", "SpotWithPriceLimit"),
+			ConflictsWhenValue("spot_price_limit", "spot_strategy", "NoSpot", "SpotAsPriceGo"),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -2532,7 +2538,7 @@ func resourceAliCloudAckNodepoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if v := d.Get("tags"); v != nil {
 			tagsMap := ConvertTags(v.(map[string]interface{}))
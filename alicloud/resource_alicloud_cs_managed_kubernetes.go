@@ -1360,7 +1360,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 	}
 
 	// modify cluster tag
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		err := updateKubernetesClusterTag(d, meta)
 		if err != nil {
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
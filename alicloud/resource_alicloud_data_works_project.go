@@ -279,7 +279,7 @@ func resourceAliCloudDataWorksProjectUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		dataWorksServiceV2 := DataWorksServiceV2{client}
 		if err := dataWorksServiceV2.SetResourceTags(d, "project"); err != nil {
 			return WrapError(err)
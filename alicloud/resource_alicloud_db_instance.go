@@ -12,6 +12,7 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/helper"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -22,6 +23,15 @@ func resourceAliCloudDBInstance() *schema.Resource {
 		Read:   resourceAliCloudDBInstanceRead,
 		Update: resourceAliCloudDBInstanceUpdate,
 		Delete: resourceAliCloudDBInstanceDelete,
+		CustomizeDiff: customdiff.All(
+			RequiredWhen("period", "instance_charge_type", string(Prepaid)),
+			ConflictsWhenValue("period", "instance_charge_type", string(Postpaid), string(Serverless)),
+			// TransformDBInstancePayType only converts between the subscription and the pay-as-you-go instances.
+			AllowedTransitions("instance_charge_type", map[string][]string{
+				string(Postpaid): {string(Prepaid)},
+				string(Prepaid):  {string(Postpaid)},
+			}),
+		),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
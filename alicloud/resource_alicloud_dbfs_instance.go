@@ -415,8 +415,8 @@ func resourceAliCloudDbfsDbfsInstanceUpdate(d *schema.ResourceData, meta interfa
 		d.SetPartial("performance_level")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		remove := oraw.(map[string]interface{})
 		create := nraw.(map[string]interface{})
 
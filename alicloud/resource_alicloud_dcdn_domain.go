@@ -450,7 +450,7 @@ func resourceAliCloudDcdnDomainUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	dcdnService := DcdnService{client}
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		if err := dcdnService.SetResourceTags(d, "DOMAIN"); err != nil {
 			return WrapError(err)
 		}
@@ -312,7 +312,7 @@ func resourceAliCloudDdosBgpInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ddosBgpServiceV2 := DdosBgpServiceV2{client}
 		if err := ddosBgpServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
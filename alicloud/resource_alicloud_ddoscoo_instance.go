@@ -363,7 +363,7 @@ func resourceAliCloudDdoscooInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := ddosCooServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -329,7 +329,7 @@ func resourceAliCloudDtsInstanceUpdate(d *schema.ResourceData, meta interface{})
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -180,7 +180,7 @@ func resourceAlicloudDtsMigrationInstanceUpdate(d *schema.ResourceData, meta int
 	dtsService := DtsService{client}
 	d.Partial(false)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -423,7 +423,7 @@ func resourceAliCloudEaisInstanceUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		eaisServiceV2 := EaisServiceV2{client}
 		if err := eaisServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
@@ -393,7 +393,7 @@ func resourceAliCloudEbsDiskReplicaGroupUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ebsServiceV2 := EbsServiceV2{client}
 		if err := ebsServiceV2.SetResourceTags(d, "DiskReplicaGroup"); err != nil {
 			return WrapError(err)
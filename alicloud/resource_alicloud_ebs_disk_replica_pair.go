@@ -430,7 +430,7 @@ func resourceAliCloudEbsDiskReplicaPairUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ebsServiceV2 := EbsServiceV2{client}
 		if err := ebsServiceV2.SetResourceTags(d, "DiskReplicaPair"); err != nil {
 			return WrapError(err)
@@ -652,7 +652,7 @@ func resourceAliCloudEbsEnterpriseSnapshotPolicyUpdate(d *schema.ResourceData, m
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ebsServiceV2 := EbsServiceV2{client}
 		if err := ebsServiceV2.SetResourceTags(d, "EnterpriseSnapshotPolicy"); err != nil {
 			return WrapError(err)
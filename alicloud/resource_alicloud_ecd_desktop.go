@@ -268,7 +268,7 @@ func resourceAlicloudEcdDesktopUpdate(d *schema.ResourceData, meta interface{})
 	var err error
 	d.Partial(true)
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		if err := ecdService.SetResourceTags(d, "ALIYUN::GWS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -1847,7 +1847,7 @@ func resourceAliCloudEciContainerGroupUpdate(d *schema.ResourceData, meta interf
 		update = true
 		request["RestartPolicy"] = d.Get("restart_policy")
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		count := 1
 		for key, value := range d.Get("tags").(map[string]interface{}) {
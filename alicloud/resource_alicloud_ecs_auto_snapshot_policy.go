@@ -421,7 +421,7 @@ func resourceAliCloudEcsAutoSnapshotPolicyUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "snapshotpolicy"); err != nil {
 			return WrapError(err)
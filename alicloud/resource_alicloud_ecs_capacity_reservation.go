@@ -253,7 +253,7 @@ func resourceAlicloudEcsCapacityReservationUpdate(d *schema.ResourceData, meta i
 	var err error
 	update := false
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := ecsService.SetResourceTags(d, "capacityreservation"); err != nil {
 			return WrapError(err)
 		}
@@ -300,7 +300,7 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		if err := ecsService.SetResourceTags(d, "ddh"); err != nil {
 			return WrapError(err)
 		}
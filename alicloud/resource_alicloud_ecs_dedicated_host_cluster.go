@@ -122,7 +122,7 @@ func resourceAlicloudEcsDedicatedHostClusterUpdate(d *schema.ResourceData, meta
 		"DedicatedHostClusterId": d.Id(),
 	}
 	request["RegionId"] = client.RegionId
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := ecsService.SetResourceTags(d, "ddhcluster"); err != nil {
 			return WrapError(err)
 		}
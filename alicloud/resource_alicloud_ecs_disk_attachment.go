@@ -16,9 +16,15 @@ func resourceAlicloudEcsDiskAttachment() *schema.Resource {
 		Read:   resourceAlicloudEcsDiskAttachmentRead,
 		Update: resourceAlicloudEcsDiskAttachmentUpdate,
 		Delete: resourceAlicloudEcsDiskAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"disk_id", "instance_id"},
+				Patterns: map[string]string{
+					"disk_id":     `^d-[a-z0-9]+$`,
+					"instance_id": `^i-[a-z0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -378,7 +378,7 @@ func resourceAliCloudEcsElasticityAssuranceUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "ELASTICITYASSURANCE"); err != nil {
 			return WrapError(err)
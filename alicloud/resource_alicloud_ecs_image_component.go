@@ -219,7 +219,7 @@ func resourceAliCloudEcsImageComponentUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "imagecomponent"); err != nil {
 			return WrapError(err)
@@ -735,7 +735,7 @@ func resourceAliCloudEcsInstanceSetUpdate(d *schema.ResourceData, meta interface
 	ecsService := EcsService{client}
 	d.Partial(false)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		instanceIds := make([]string, 0)
 		for _, v := range d.Get("instance_ids").([]interface{}) {
 			instanceIds = append(instanceIds, fmt.Sprint(v))
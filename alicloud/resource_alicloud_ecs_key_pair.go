@@ -231,7 +231,7 @@ func resourceAliCloudEcsKeyPairUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "keypair"); err != nil {
 			return WrapError(err)
@@ -1285,7 +1285,7 @@ func resourceAliCloudEcsLaunchTemplateUpdate(d *schema.ResourceData, meta interf
 		systemDiskMap["PerformanceLevel"] = diskMap["performance_level"]
 		request["SystemDisk"] = systemDiskMap
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 	}
 	if v, ok := d.GetOk("tags"); ok {
@@ -374,7 +374,7 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := ecsService.SetResourceTags(d, "eni"); err != nil {
 			return WrapError(err)
 		}
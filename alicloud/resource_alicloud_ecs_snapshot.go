@@ -305,7 +305,7 @@ func resourceAliCloudEcsSnapshotUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "snapshot"); err != nil {
 			return WrapError(err)
@@ -740,7 +740,7 @@ func resourceAliCloudEfloClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Cluster"); err != nil {
 			return WrapError(err)
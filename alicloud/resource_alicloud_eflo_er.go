@@ -221,7 +221,7 @@ func resourceAliCloudEfloErUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Er"); err != nil {
 			return WrapError(err)
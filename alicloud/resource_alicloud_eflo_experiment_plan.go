@@ -214,7 +214,7 @@ func resourceAliCloudEfloExperimentPlanUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "ExperimentPlan"); err != nil {
 			return WrapError(err)
@@ -468,7 +468,7 @@ func resourceAliCloudEfloHyperNodeUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "HyperNode"); err != nil {
 			return WrapError(err)
@@ -982,7 +982,7 @@ func resourceAliCloudEfloNodeUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Node"); err != nil {
 			return WrapError(err)
@@ -300,7 +300,7 @@ func resourceAliCloudEfloVpdUpdate(d *schema.ResourceData, meta interface{}) err
 			}
 		}
 	}
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Vpd"); err != nil {
 			return WrapError(err)
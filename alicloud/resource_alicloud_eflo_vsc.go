@@ -183,7 +183,7 @@ func resourceAliCloudEfloVscUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		efloServiceV2 := EfloServiceV2{client}
 		if err := efloServiceV2.SetResourceTags(d, "Vsc"); err != nil {
 			return WrapError(err)
@@ -593,7 +593,7 @@ func resourceAliCloudEipAddressUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		eipServiceV2 := EipServiceV2{client}
 		if err := eipServiceV2.SetResourceTags(d, "EIP"); err != nil {
 			return WrapError(err)
@@ -271,7 +271,7 @@ func resourceAliCloudEipanycastAnycastEipAddressUpdate(d *schema.ResourceData, m
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		eipanycastServiceV2 := EipanycastServiceV2{client}
 		if err := eipanycastServiceV2.SetResourceTags(d, "ANYCASTEIPADDRESS"); err != nil {
 			return WrapError(err)
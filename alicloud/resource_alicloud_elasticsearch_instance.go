@@ -2169,7 +2169,7 @@ func resourceAliCloudElasticsearchInstanceUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		elasticsearchServiceV2 := ElasticsearchServiceV2{client}
 		if err := elasticsearchServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
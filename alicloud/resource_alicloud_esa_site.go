@@ -1455,7 +1455,7 @@ func resourceAliCloudEsaSiteUpdate(d *schema.ResourceData, meta interface{}) err
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		esaServiceV2 := EsaServiceV2{client}
 		if err := esaServiceV2.SetResourceTags(d, "Site"); err != nil {
 			return WrapError(err)
@@ -1617,7 +1617,7 @@ func resourceAliyunEssEciScalingConfigurationUpdate(d *schema.ResourceData, meta
 		update = true
 		request["LoadBalancerWeight"] = d.Get("load_balancer_weight")
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		count := 1
 		for key, value := range d.Get("tags").(map[string]interface{}) {
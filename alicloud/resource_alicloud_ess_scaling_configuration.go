@@ -1442,7 +1442,7 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		}
 		update = true
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if v, ok := d.GetOk("tags"); ok {
 			tags := "{"
 			for key, value := range v.(map[string]interface{}) {
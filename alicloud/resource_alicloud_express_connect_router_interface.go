@@ -608,7 +608,7 @@ func resourceAliCloudExpressConnectRouterInterfaceUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		expressConnectServiceV2 := ExpressConnectServiceV2{client}
 		if err := expressConnectServiceV2.SetResourceTags(d, "ROUTERINTERFACE"); err != nil {
 			return WrapError(err)
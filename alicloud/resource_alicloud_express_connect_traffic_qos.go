@@ -201,7 +201,7 @@ func resourceAliCloudExpressConnectTrafficQosUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		expressConnectServiceV2 := ExpressConnectServiceV2{client}
 		if err := expressConnectServiceV2.SetResourceTags(d, "TRAFFICQOS"); err != nil {
 			return WrapError(err)
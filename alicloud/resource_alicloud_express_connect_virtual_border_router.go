@@ -519,7 +519,7 @@ func resourceAliCloudExpressConnectVirtualBorderRouterUpdate(d *schema.ResourceD
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		expressConnectServiceV2 := ExpressConnectServiceV2{client}
 		if err := expressConnectServiceV2.SetResourceTags(d, "VIRTUALBORDERROUTER"); err != nil {
 			return WrapError(err)
@@ -401,7 +401,7 @@ func resourceAlicloudFCServiceUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		fcService := FcService{client}
 		resourceArn, err := parseResourceArn(d, meta)
 		if err != nil {
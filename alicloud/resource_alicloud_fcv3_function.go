@@ -1948,7 +1948,7 @@ func resourceAliCloudFcv3FunctionUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		fcv3ServiceV2 := Fcv3ServiceV2{client}
 		if err := fcv3ServiceV2.SetResourceTags(d, "function"); err != nil {
 			return WrapError(err)
@@ -17,9 +17,14 @@ func resourceAliCloudNatGatewayForwardEntry() *schema.Resource {
 		Read:   resourceAliCloudNatGatewayForwardEntryRead,
 		Update: resourceAliCloudNatGatewayForwardEntryUpdate,
 		Delete: resourceAliCloudNatGatewayForwardEntryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"forward_table_id", "forward_entry_id"},
+				Patterns: map[string]string{
+					"forward_entry_id": `^fwd-[a-z0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -225,7 +225,7 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := gaService.SetResourceTags(d, "accelerator"); err != nil {
 			return WrapError(err)
 		}
@@ -217,7 +217,7 @@ func resourceAliCloudGaBasicAcceleratorUpdate(d *schema.ResourceData, meta inter
 		"ClientToken":   buildClientToken("UpdateBasicAccelerator"),
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := gaService.SetResourceTags(d, "basicaccelerator"); err != nil {
 			return WrapError(err)
 		}
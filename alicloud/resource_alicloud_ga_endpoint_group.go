@@ -435,7 +435,7 @@ func resourceAliCloudGaEndpointGroupUpdate(d *schema.ResourceData, meta interfac
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := gaService.SetResourceTags(d, "endpointgroup"); err != nil {
 			return WrapError(err)
 		}
@@ -278,7 +278,7 @@ func resourceAlicloudGpdbElasticInstanceUpdate(d *schema.ResourceData, meta inte
 	gpdbService := GpdbService{client}
 	d.Partial(true)
 	var err error
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := gpdbService.SetResourceTags(d, "ALIYUN::GPDB::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
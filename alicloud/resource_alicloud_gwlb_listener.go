@@ -196,7 +196,7 @@ func resourceAliCloudGwlbListenerUpdate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		gwlbServiceV2 := GwlbServiceV2{client}
 		if err := gwlbServiceV2.SetResourceTags(d, "listener"); err != nil {
 			return WrapError(err)
@@ -363,7 +363,7 @@ func resourceAliCloudGwlbLoadBalancerUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		gwlbServiceV2 := GwlbServiceV2{client}
 		if err := gwlbServiceV2.SetResourceTags(d, "loadbalancer"); err != nil {
 			return WrapError(err)
@@ -835,7 +835,7 @@ func resourceAliCloudGwlbServerGroupUpdate(d *schema.ResourceData, meta interfac
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		gwlbServiceV2 := GwlbServiceV2{client}
 		if err := gwlbServiceV2.SetResourceTags(d, "servergroup"); err != nil {
 			return WrapError(err)
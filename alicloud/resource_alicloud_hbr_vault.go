@@ -274,7 +274,7 @@ func resourceAliCloudHbrVaultUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		hbrServiceV2 := HbrServiceV2{client}
 		if err := hbrServiceV2.SetResourceTags(d, "vault"); err != nil {
 			return WrapError(err)
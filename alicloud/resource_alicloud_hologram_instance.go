@@ -709,7 +709,7 @@ func resourceAliCloudHologramInstanceUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		hologramServiceV2 := HologramServiceV2{client}
 		if err := hologramServiceV2.SetResourceTags(d, ""); err != nil {
 			return WrapError(err)
@@ -472,7 +472,7 @@ func resourceAliCloudEcsImageUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ecsServiceV2 := EcsServiceV2{client}
 		if err := ecsServiceV2.SetResourceTags(d, "image"); err != nil {
 			return WrapError(err)
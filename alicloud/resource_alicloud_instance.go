@@ -12,6 +12,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -23,9 +24,25 @@ func resourceAliCloudInstance() *schema.Resource {
 		Read:   resourceAliCloudInstanceRead,
 		Update: resourceAliCloudInstanceUpdate,
 		Delete: resourceAliCloudInstanceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		CustomizeDiff: customdiff.All(
+			ConflictsWhenValue("period", "instance_charge_type", string(common.PostPaid)),
+			ConflictsWhenValue("spot_price_limit", "spot_strategy", "NoSpot", "SpotAsPriceGo"),
+			// The system disk can only be shrunk by replacing it with a new image.
+			customdiff.If(func(diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.HasChange("image_id")
+			}, ForceNewIfDecreased("system_disk_size")),
+			resourceAliCloudInstanceIpv6CustomizeDiff,
+		),
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"instance_id"},
+				Patterns: map[string]string{
+					"instance_id": `^i-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("ecs", "instance"),
+			importIdByName("Ecs", "2014-05-26", "DescribeInstances", "InstanceName", "$.Instances.Instance[*]", "InstanceName", "InstanceId", map[string]interface{}{"PageSize": PageSizeXLarge}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -777,6 +794,35 @@ func resourceAliCloudInstance() *schema.Resource {
 	}
 }
 
+// resourceAliCloudInstanceIpv6CustomizeDiff checks the vswitch has an IPv6 CIDR block before assigning the IPv6
+// addresses to the instance.
+func resourceAliCloudInstanceIpv6CustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !customizeDiffShouldCheck(diff, "vswitch_id", "ipv6_address_count", "ipv6_addresses") {
+		return nil
+	}
+	vswitchId, ok := diff.GetOk("vswitch_id")
+	if !ok {
+		return nil
+	}
+	_, countOk := diff.GetOk("ipv6_address_count")
+	_, addressesOk := diff.GetOk("ipv6_addresses")
+	if !countOk && !addressesOk {
+		return nil
+	}
+	vpcServiceV2 := VpcServiceV2{meta.(*connectivity.AliyunClient)}
+	object, err := vpcServiceV2.DescribeVpcVswitch(vswitchId.(string))
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	if v, ok := object["Ipv6CidrBlock"].(string); !ok || v == "" {
+		return fmt.Errorf("\"ipv6_address_count\" and \"ipv6_addresses\" can not be set, because the vswitch %s has no IPv6 CIDR block", vswitchId)
+	}
+	return nil
+}
+
 func resourceAliCloudInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
@@ -797,7 +797,7 @@ func resourceAliCloudKmsInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		kmsServiceV2 := KmsServiceV2{client}
 		if err := kmsServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
@@ -467,7 +467,7 @@ func resourceAliCloudKmsSecretUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("description")
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		if err := kmsService.SetResourceTags(d, "secret"); err != nil {
 			return WrapError(err)
 		}
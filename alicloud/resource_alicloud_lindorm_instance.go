@@ -618,7 +618,7 @@ func resourceAliCloudLindormInstanceUpdate(d *schema.ResourceData, meta interfac
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := hitsdbService.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -300,7 +300,7 @@ func resourceAliCloudLiveDomainUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		liveServiceV2 := LiveServiceV2{client}
 		if err := liveServiceV2.SetLiveResourceTags(d, "DOMAIN"); err != nil {
 			return WrapError(err)
@@ -231,7 +231,7 @@ func resourceAliCloudSlsProjectUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		slsServiceV2 := SlsServiceV2{client}
 		if err := slsServiceV2.SetResourceTags(d, "PROJECT"); err != nil {
 			return WrapError(err)
@@ -16,7 +16,7 @@ import (
 )
 
 func resourceAliCloudSlsLogStore() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create: resourceAliCloudSlsLogStoreCreate,
 		Read:   resourceAliCloudSlsLogStoreRead,
 		Update: resourceAliCloudSlsLogStoreUpdate,
@@ -201,7 +201,14 @@ func resourceAliCloudSlsLogStore() *schema.Resource {
 				},
 			},
 		},
-	}
+	},
+		// the deprecated project and name are moved to the project_name and logstore_name since the schema version 1
+		stateUpgrade{
+			renameStateAttribute("project", "project_name"),
+			renameStateAttribute("name", "logstore_name"),
+			formatStateId(COLON_SEPARATED, "project_name", "logstore_name"),
+		},
+	)
 }
 
 func resourceAliCloudSlsLogStoreCreate(d *schema.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAliCloudLogStore_basic(t *testing.T) {
@@ -1900,3 +1901,28 @@ func TestAccAliCloudLogStore_infrequest_access_ttl(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitAliCloudSlsLogStoreStateUpgradeV0(t *testing.T) {
+	upgrader := resourceAliCloudSlsLogStore().StateUpgraders[0]
+	rawState, err := upgrader.Upgrade(map[string]interface{}{
+		"id":          "tf-project:tf-store",
+		"project":     "tf-project",
+		"name":        "tf-store",
+		"shard_count": 2,
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-project", rawState["project_name"])
+	assert.Equal(t, "tf-store", rawState["logstore_name"])
+	assert.Equal(t, "tf-project:tf-store", rawState["id"])
+	assert.Equal(t, 2, rawState["shard_count"])
+
+	rawState, err = upgrader.Upgrade(map[string]interface{}{
+		"id":            "tf-store",
+		"project_name":  "tf-project",
+		"logstore_name": "tf-store",
+		"project":       "",
+		"name":          nil,
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-project:tf-store", rawState["id"], "the id should be formatted as <project_name>:<logstore_name>")
+}
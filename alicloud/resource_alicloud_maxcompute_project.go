@@ -803,7 +803,7 @@ func resourceAliCloudMaxComputeProjectUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := maxComputeServiceV2.SetResourceTags(d, "project"); err != nil {
 			return WrapError(err)
 		}
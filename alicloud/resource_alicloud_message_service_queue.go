@@ -343,7 +343,7 @@ func resourceAliCloudMessageServiceQueueUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		messageServiceServiceV2 := MessageServiceServiceV2{client}
 		if err := messageServiceServiceV2.SetResourceTags(d, "queue"); err != nil {
 			return WrapError(err)
@@ -198,7 +198,7 @@ func resourceAliCloudMessageServiceTopicUpdate(d *schema.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		messageServiceServiceV2 := MessageServiceServiceV2{client}
 		if err := messageServiceServiceV2.SetResourceTags(d, "topic"); err != nil {
 			return WrapError(err)
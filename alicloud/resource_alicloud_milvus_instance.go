@@ -468,7 +468,7 @@ func resourceAliCloudMilvusInstanceUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		milvusServiceV2 := MilvusServiceV2{client}
 		if err := milvusServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
@@ -263,7 +263,7 @@ func resourceAlicloudMongodbServerlessInstanceUpdate(d *schema.ResourceData, met
 	MongoDBService := MongoDBService{client}
 	var response map[string]interface{}
 	d.Partial(true)
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := MongoDBService.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -421,7 +421,7 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		mseServiceV2 := MseService{client}
 		if err := mseServiceV2.SetResourceTags(d, "CLUSTER"); err != nil {
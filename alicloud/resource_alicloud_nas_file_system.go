@@ -907,7 +907,7 @@ func resourceAliCloudNasFileSystemUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		nasServiceV2 := NasServiceV2{client}
 		if err := nasServiceV2.SetResourceTags(d, "filesystem"); err != nil {
 			return WrapError(err)
@@ -421,7 +421,7 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := vpcServiceV2.SetResourceTags(d, "NATGATEWAY"); err != nil {
 			return WrapError(err)
 		}
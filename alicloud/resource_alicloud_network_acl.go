@@ -583,7 +583,7 @@ func resourceAliCloudVpcNetworkAclUpdate(d *schema.ResourceData, meta interface{
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "NETWORKACL"); err != nil {
 			return WrapError(err)
@@ -19,9 +19,15 @@ func resourceAliCloudNlbListener() *schema.Resource {
 		Read:   resourceAliCloudNlbListenerRead,
 		Update: resourceAliCloudNlbListenerUpdate,
 		Delete: resourceAliCloudNlbListenerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"listener_id"},
+				Patterns: map[string]string{
+					"listener_id": `^lsn-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("nlb", "listener"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -589,7 +595,7 @@ func resourceAliCloudNlbListenerUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		nlbServiceV2 := NlbServiceV2{client}
 		if err := nlbServiceV2.SetResourceTags(d, "listener"); err != nil {
 			return WrapError(err)
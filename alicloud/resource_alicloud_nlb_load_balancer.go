@@ -18,9 +18,16 @@ func resourceAliCloudNlbLoadBalancer() *schema.Resource {
 		Read:   resourceAliCloudNlbLoadBalancerRead,
 		Update: resourceAliCloudNlbLoadBalancerUpdate,
 		Delete: resourceAliCloudNlbLoadBalancerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"load_balancer_id"},
+				Patterns: map[string]string{
+					"load_balancer_id": `^nlb-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("nlb", "loadbalancer"),
+			importIdByName("Nlb", "2022-04-30", "ListLoadBalancers", "LoadBalancerNames.1", "$.LoadBalancers[*]", "LoadBalancerName", "LoadBalancerId", nil),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -797,7 +804,7 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := nlbServiceV2.SetResourceTags(d, "loadbalancer"); err != nil {
 			return WrapError(err)
 		}
@@ -248,7 +248,7 @@ func resourceAliCloudNlbSecurityPolicyUpdate(d *schema.ResourceData, meta interf
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		nlbServiceV2 := NlbServiceV2{client}
 		if err := nlbServiceV2.SetResourceTags(d, "securitypolicy"); err != nil {
 			return WrapError(err)
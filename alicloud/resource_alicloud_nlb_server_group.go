@@ -17,9 +17,15 @@ func resourceAliCloudNlbServerGroup() *schema.Resource {
 		Read:   resourceAliCloudNlbServerGroupRead,
 		Update: resourceAliCloudNlbServerGroupUpdate,
 		Delete: resourceAliCloudNlbServerGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"server_group_id"},
+				Patterns: map[string]string{
+					"server_group_id": `^sgp-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("nlb", "servergroup"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -569,7 +575,7 @@ func resourceAliCloudNlbServerGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		nlbServiceV2 := NlbServiceV2{client}
 		if err := nlbServiceV2.SetResourceTags(d, "servergroup"); err != nil {
 			return WrapError(err)
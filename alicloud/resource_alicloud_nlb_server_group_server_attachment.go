@@ -20,9 +20,21 @@ func resourceAliCloudNlbServerGroupServerAttachment() *schema.Resource {
 		Read:   resourceAliCloudNlbServerGroupServerAttachmentRead,
 		Update: resourceAliCloudNlbServerGroupServerAttachmentUpdate,
 		Delete: resourceAliCloudNlbServerGroupServerAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"server_group_id", "server_id", "server_ip", "server_type", "port"},
+				Patterns: map[string]string{
+					"port": `^[0-9]+$`,
+				},
+				Separator: "_",
+			},
+			importIdFromFormat(ImportIdFormat{
+				Parts: []string{"server_group_id", "server_id", "server_type", "port"},
+				Patterns: map[string]string{
+					"port": `^[0-9]+$`,
+				},
+			}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -115,7 +115,7 @@ func resourceAlicloudOosApplicationUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 	request["RegionId"] = client.RegionId
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
@@ -158,7 +158,7 @@ func resourceAlicloudOosParameterUpdate(d *schema.ResourceData, meta interface{}
 			request["ResourceGroupId"] = v
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
@@ -332,7 +332,7 @@ func resourceAliCloudOosPatchBaselineUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		oosServiceV2 := OosServiceV2{client}
 		if err := oosServiceV2.SetOssResourceTags(d, "patchbaseline"); err != nil {
 			return WrapError(err)
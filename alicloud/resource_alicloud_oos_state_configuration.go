@@ -198,7 +198,7 @@ func resourceAlicloudOosStateConfigurationUpdate(d *schema.ResourceData, meta in
 		update = true
 		request["ScheduleType"] = d.Get("schedule_type")
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
@@ -16,12 +17,13 @@ import (
 
 func resourceAlicloudOssBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlicloudOssBucketCreate,
-		Read:   resourceAlicloudOssBucketRead,
-		Update: resourceAlicloudOssBucketUpdate,
-		Delete: resourceAlicloudOssBucketDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceAlicloudOssBucketCreate,
+		Read:     resourceAlicloudOssBucketRead,
+		Update:   resourceAlicloudOssBucketUpdate,
+		Delete:   resourceAlicloudOssBucketDelete,
+		Importer: ossBucketImporter(),
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -1073,7 +1075,7 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("server_side_encryption_rule")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := resourceAlicloudOssBucketTaggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
@@ -1749,42 +1751,24 @@ func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutDelete))
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err = client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 			return nil, ossClient.DeleteBucket(d.Id())
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"BucketNotEmpty"}) {
-				if d.Get("force_destroy").(bool) {
-					raw, er := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-						bucket, _ := ossClient.Bucket(d.Id())
-						lor, err := bucket.ListObjectVersions()
-						if err != nil {
-							return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), "ListObjectVersions", AliyunOssGoSdk)
-						}
-						addDebug("ListObjectVersions", lor, requestInfo)
-						objectsToDelete := make([]oss.DeleteObject, 0)
-						for _, object := range lor.ObjectDeleteMarkers {
-							objectsToDelete = append(objectsToDelete, oss.DeleteObject{
-								Key:       object.Key,
-								VersionId: object.VersionId,
-							})
-						}
-
-						for _, object := range lor.ObjectVersions {
-							objectsToDelete = append(objectsToDelete, oss.DeleteObject{
-								Key:       object.Key,
-								VersionId: object.VersionId,
-							})
-						}
-						return bucket.DeleteObjectVersions(objectsToDelete)
-					})
-					if er != nil {
-						return resource.NonRetryableError(er)
-					}
-					addDebug("DeleteObjectVersions", raw, requestInfo, map[string]string{"bucketName": d.Id()})
-					return resource.RetryableError(err)
+			if IsExpectedErrors(err, []string{"BucketNotEmpty"}) && d.Get("force_destroy").(bool) {
+				raw, er := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+					return ossClient.Bucket(d.Id())
+				})
+				if er != nil {
+					return resource.NonRetryableError(WrapErrorf(er, DefaultErrorMsg, d.Id(), "Bucket", AliyunOssGoSdk))
+				}
+				if er := newOssBucketEmptier(d.Id(), raw.(*oss.Bucket), deadline).empty(); er != nil {
+					return resource.NonRetryableError(er)
 				}
+				// the objects may be written during emptying, and then the bucket is emptied again
+				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
 		}
@@ -1797,6 +1781,235 @@ func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) e
 	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
 }
 
+const (
+	// OssDeleteObjectsBatchSize is the maximum number of the object versions deleted by one DeleteMultipleObjects.
+	OssDeleteObjectsBatchSize = 1000
+	// OssForceDestroyConcurrency is the number of the requests sent at the same time when emptying a bucket.
+	OssForceDestroyConcurrency = 10
+)
+
+// ossBucketAPI is the part of oss.Bucket used to empty the bucket.
+type ossBucketAPI interface {
+	ListObjectVersions(options ...oss.Option) (oss.ListObjectVersionsResult, error)
+	DeleteObjectVersions(objectVersions []oss.DeleteObject, options ...oss.Option) (oss.DeleteObjectVersionsResult, error)
+	ListMultipartUploads(options ...oss.Option) (oss.ListMultipartUploadResult, error)
+	AbortMultipartUpload(imur oss.InitiateMultipartUploadResult, options ...oss.Option) error
+	ListLiveChannel(options ...oss.Option) (oss.ListLiveChannelResult, error)
+	DeleteLiveChannel(channelName string) error
+}
+
+// ossBucketEmptier removes everything which blocks the bucket from being deleted when force_destroy is set: the
+// in-progress multipart uploads, the LiveChannels, and all of the object versions and delete markers. The pages are
+// listed one by one and each of them is deleted by the workers in the background, so the memory does not grow with
+// the number of the objects. Emptying stops with an error when the deadline is exceeded.
+type ossBucketEmptier struct {
+	name     string
+	bucket   ossBucketAPI
+	deadline time.Time
+
+	workers chan struct{}
+	wg      sync.WaitGroup
+	mutex   sync.Mutex
+	err     error
+
+	deletedVersions  int
+	abortedUploads   int
+	deletedChannels  int
+	lastProgressTime time.Time
+}
+
+func newOssBucketEmptier(name string, bucket ossBucketAPI, deadline time.Time) *ossBucketEmptier {
+	return &ossBucketEmptier{
+		name:     name,
+		bucket:   bucket,
+		deadline: deadline,
+		workers:  make(chan struct{}, OssForceDestroyConcurrency),
+	}
+}
+
+func (e *ossBucketEmptier) empty() error {
+	log.Printf("[INFO] Emptying the OSS bucket %s before deleting it.", e.name)
+	steps := []func() error{e.abortMultipartUploads, e.deleteLiveChannels, e.deleteObjectVersions}
+	for _, step := range steps {
+		err := step()
+		e.wg.Wait()
+		if err == nil {
+			err = e.failure()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	log.Printf("[INFO] The OSS bucket %s has been emptied: %d object versions and delete markers deleted, %d multipart uploads aborted, %d LiveChannels deleted.",
+		e.name, e.deletedVersions, e.abortedUploads, e.deletedChannels)
+	return nil
+}
+
+func (e *ossBucketEmptier) abortMultipartUploads() error {
+	keyMarker, uploadIdMarker := "", ""
+	for {
+		var result oss.ListMultipartUploadResult
+		err := e.retry(func() (err error) {
+			result, err = e.bucket.ListMultipartUploads(oss.MaxUploads(OssDeleteObjectsBatchSize), oss.KeyMarker(keyMarker), oss.UploadIDMarker(uploadIdMarker))
+			return err
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, e.name, "ListMultipartUploads", AliyunOssGoSdk)
+		}
+		for _, upload := range result.Uploads {
+			imur := oss.InitiateMultipartUploadResult{Bucket: e.name, Key: upload.Key, UploadID: upload.UploadID}
+			if !e.submit(func() error {
+				if err := e.retry(func() error { return e.bucket.AbortMultipartUpload(imur) }); err != nil && !IsExpectedErrors(err, []string{"NoSuchUpload"}) {
+					return WrapErrorf(err, DefaultErrorMsg, e.name, "AbortMultipartUpload", AliyunOssGoSdk)
+				}
+				e.progress(0, 1, 0)
+				return nil
+			}) {
+				return nil
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		keyMarker, uploadIdMarker = result.NextKeyMarker, result.NextUploadIDMarker
+	}
+}
+
+func (e *ossBucketEmptier) deleteLiveChannels() error {
+	marker := ""
+	for {
+		var result oss.ListLiveChannelResult
+		err := e.retry(func() (err error) {
+			result, err = e.bucket.ListLiveChannel(oss.MaxKeys(OssDeleteObjectsBatchSize), oss.Marker(marker))
+			return err
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, e.name, "ListLiveChannel", AliyunOssGoSdk)
+		}
+		for _, channel := range result.LiveChannel {
+			name := channel.Name
+			if !e.submit(func() error {
+				if err := e.retry(func() error { return e.bucket.DeleteLiveChannel(name) }); err != nil && !IsExpectedErrors(err, []string{"NoSuchLiveChannel"}) {
+					return WrapErrorf(err, DefaultErrorMsg, e.name, "DeleteLiveChannel", AliyunOssGoSdk)
+				}
+				e.progress(0, 0, 1)
+				return nil
+			}) {
+				return nil
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+func (e *ossBucketEmptier) deleteObjectVersions() error {
+	keyMarker, versionIdMarker := "", ""
+	for {
+		var result oss.ListObjectVersionsResult
+		err := e.retry(func() (err error) {
+			result, err = e.bucket.ListObjectVersions(oss.MaxKeys(OssDeleteObjectsBatchSize), oss.KeyMarker(keyMarker), oss.VersionIdMarker(versionIdMarker))
+			return err
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, e.name, "ListObjectVersions", AliyunOssGoSdk)
+		}
+		objects := make([]oss.DeleteObject, 0, len(result.ObjectVersions)+len(result.ObjectDeleteMarkers))
+		for _, object := range result.ObjectDeleteMarkers {
+			objects = append(objects, oss.DeleteObject{Key: object.Key, VersionId: object.VersionId})
+		}
+		for _, object := range result.ObjectVersions {
+			objects = append(objects, oss.DeleteObject{Key: object.Key, VersionId: object.VersionId})
+		}
+		for start := 0; start < len(objects); start += OssDeleteObjectsBatchSize {
+			end := start + OssDeleteObjectsBatchSize
+			if end > len(objects) {
+				end = len(objects)
+			}
+			batch := objects[start:end]
+			if !e.submit(func() error {
+				if err := e.retry(func() error {
+					_, err := e.bucket.DeleteObjectVersions(batch, oss.DeleteObjectsQuiet(true))
+					return err
+				}); err != nil {
+					return WrapErrorf(err, DefaultErrorMsg, e.name, "DeleteObjectVersions", AliyunOssGoSdk)
+				}
+				e.progress(len(batch), 0, 0)
+				return nil
+			}) {
+				return nil
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		keyMarker, versionIdMarker = result.NextKeyMarker, result.NextVersionIdMarker
+	}
+}
+
+// submit runs the job once a worker is idle. It returns false without running the job if a job has failed.
+func (e *ossBucketEmptier) submit(job func() error) bool {
+	e.workers <- struct{}{}
+	if e.failure() != nil {
+		<-e.workers
+		return false
+	}
+	e.wg.Add(1)
+	go func() {
+		defer func() {
+			<-e.workers
+			e.wg.Done()
+		}()
+		if err := job(); err != nil {
+			e.mutex.Lock()
+			if e.err == nil {
+				e.err = err
+			}
+			e.mutex.Unlock()
+		}
+	}()
+	return true
+}
+
+func (e *ossBucketEmptier) failure() error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.err
+}
+
+// retry retries the request on the retryable errors until the deadline.
+func (e *ossBucketEmptier) retry(request func() error) error {
+	timeout := time.Until(e.deadline)
+	if timeout <= 0 {
+		return fmt.Errorf("the delete timeout is exceeded when emptying the bucket %s", e.name)
+	}
+	return resource.Retry(timeout, func() *resource.RetryError {
+		if err := request(); err != nil {
+			if NeedRetry(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// progress counts the deleted resources, and logs the progress every 10 seconds.
+func (e *ossBucketEmptier) progress(versions, uploads, channels int) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.deletedVersions += versions
+	e.abortedUploads += uploads
+	e.deletedChannels += channels
+	if time.Since(e.lastProgressTime) >= 10*time.Second {
+		e.lastProgressTime = time.Now()
+		log.Printf("[INFO] Emptying the OSS bucket %s: %d object versions and delete markers deleted, %d multipart uploads aborted, %d LiveChannels deleted.",
+			e.name, e.deletedVersions, e.abortedUploads, e.deletedChannels)
+	}
+}
+
 func expirationHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -1847,3 +2060,17 @@ func abortMultipartUploadHash(v interface{}) int {
 	}
 	return helper.Hashcode(buf.String())
 }
+
+// ossBucketImporter is the importer of the bucket and the bucket configuration resources whose id is the bucket name.
+// They can also be imported by the bucket ARN acs:oss:*:<account_id>:<bucket>.
+func ossBucketImporter() *schema.ResourceImporter {
+	return ImportStateWithIdFormat(
+		ImportIdFormat{
+			Parts: []string{"bucket"},
+			Patterns: map[string]string{
+				"bucket": `^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`,
+			},
+		},
+		importIdFromArn("oss", ""),
+	)
+}
@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketAccessMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketAccessMonitorCreate,
-		Read:   resourceAliCloudOssBucketAccessMonitorRead,
-		Update: resourceAliCloudOssBucketAccessMonitorUpdate,
-		Delete: resourceAliCloudOssBucketAccessMonitorDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketAccessMonitorCreate,
+		Read:     resourceAliCloudOssBucketAccessMonitorRead,
+		Update:   resourceAliCloudOssBucketAccessMonitorUpdate,
+		Delete:   resourceAliCloudOssBucketAccessMonitorDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
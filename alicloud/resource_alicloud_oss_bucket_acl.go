@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketAclCreate,
-		Read:   resourceAliCloudOssBucketAclRead,
-		Update: resourceAliCloudOssBucketAclUpdate,
-		Delete: resourceAliCloudOssBucketAclDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketAclCreate,
+		Read:     resourceAliCloudOssBucketAclRead,
+		Update:   resourceAliCloudOssBucketAclUpdate,
+		Delete:   resourceAliCloudOssBucketAclDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketArchiveDirectRead() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketArchiveDirectReadCreate,
-		Read:   resourceAliCloudOssBucketArchiveDirectReadRead,
-		Update: resourceAliCloudOssBucketArchiveDirectReadUpdate,
-		Delete: resourceAliCloudOssBucketArchiveDirectReadDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketArchiveDirectReadCreate,
+		Read:     resourceAliCloudOssBucketArchiveDirectReadRead,
+		Update:   resourceAliCloudOssBucketArchiveDirectReadUpdate,
+		Delete:   resourceAliCloudOssBucketArchiveDirectReadDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
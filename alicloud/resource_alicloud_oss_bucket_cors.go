@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketCors() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketCorsCreate,
-		Read:   resourceAliCloudOssBucketCorsRead,
-		Update: resourceAliCloudOssBucketCorsUpdate,
-		Delete: resourceAliCloudOssBucketCorsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketCorsCreate,
+		Read:     resourceAliCloudOssBucketCorsRead,
+		Update:   resourceAliCloudOssBucketCorsUpdate,
+		Delete:   resourceAliCloudOssBucketCorsDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketHttpsConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketHttpsConfigCreate,
-		Read:   resourceAliCloudOssBucketHttpsConfigRead,
-		Update: resourceAliCloudOssBucketHttpsConfigUpdate,
-		Delete: resourceAliCloudOssBucketHttpsConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketHttpsConfigCreate,
+		Read:     resourceAliCloudOssBucketHttpsConfigRead,
+		Update:   resourceAliCloudOssBucketHttpsConfigUpdate,
+		Delete:   resourceAliCloudOssBucketHttpsConfigDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
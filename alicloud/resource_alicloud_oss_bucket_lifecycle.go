@@ -0,0 +1,674 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var ossBucketLifecycleStorageClasses = []string{"IA", "Archive", "ColdArchive", "DeepColdArchive"}
+
+func resourceAliCloudOssBucketLifecycle() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceAliCloudOssBucketLifecycleCreate,
+		Read:     resourceAliCloudOssBucketLifecycleRead,
+		Update:   resourceAliCloudOssBucketLifecycleUpdate,
+		Delete:   resourceAliCloudOssBucketLifecycleDelete,
+		Importer: ossBucketImporter(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"allow_same_action_overlap": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1000,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: StringLenBetween(0, 255),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: StringInSlice([]string{"Enabled", "Disabled"}, false),
+						},
+						"tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"filter": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"object_size_greater_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntAtLeast(0),
+									},
+									"object_size_less_than": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntAtLeast(0),
+									},
+									"not": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"tag": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"key": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"value": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntAtLeast(1),
+									},
+									"created_before_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateOssBucketDateTimestamp,
+									},
+									"expired_object_delete_marker": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntAtLeast(1),
+									},
+									"created_before_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateOssBucketDateTimestamp,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: StringInSlice(ossBucketLifecycleStorageClasses, false),
+									},
+									"is_access_time": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"return_to_std_when_visit": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"allow_small_file": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"abort_multipart_upload": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: IntAtLeast(1),
+									},
+									"created_before_date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateOssBucketDateTimestamp,
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: IntAtLeast(1),
+									},
+								},
+							},
+						},
+						"noncurrent_version_transition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: IntAtLeast(1),
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: StringInSlice(ossBucketLifecycleStorageClasses, false),
+									},
+									"is_access_time": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"return_to_std_when_visit": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"allow_small_file": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAliCloudOssBucketLifecycleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+	bucket := d.Get("bucket").(string)
+
+	// The lifecycle configuration is a single document of the bucket. If it already exists, it is owned by
+	// the lifecycle_rule of alicloud_oss_bucket or by another alicloud_oss_bucket_lifecycle, and overwriting
+	// it here makes the two owners revert each other on every apply.
+	object, err := ossServiceV2.DescribeOssBucketLifecycle(bucket)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err == nil && len(convertToInterfaceArray(object["Rule"])) > 0 {
+		return WrapError(Error("The bucket %s already has lifecycle rules, which may be managed by the 'lifecycle_rule' of alicloud_oss_bucket. "+
+			"Please remove them from alicloud_oss_bucket and import them by 'terraform import alicloud_oss_bucket_lifecycle.<name> %s' instead.", bucket, bucket))
+	}
+
+	d.SetId(bucket)
+	if err := ossBucketLifecyclePut(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_oss_bucket_lifecycle", "PutBucketLifecycle", AlibabaCloudSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{}, []string{"#CHECKSET"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, ossServiceV2.OssBucketLifecycleStateRefreshFunc(d.Id(), "#$.Rule", []string{}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliCloudOssBucketLifecycleRead(d, meta)
+}
+
+func resourceAliCloudOssBucketLifecycleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ossServiceV2 := OssServiceV2{client}
+
+	objectRaw, err := ossServiceV2.DescribeOssBucketLifecycle(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_oss_bucket_lifecycle DescribeOssBucketLifecycle Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	if err := d.Set("rule", flattenOssBucketLifecycleRules(objectRaw["Rule"])); err != nil {
+		return err
+	}
+
+	d.Set("bucket", d.Id())
+
+	return nil
+}
+
+func resourceAliCloudOssBucketLifecycleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if d.HasChanges("rule", "allow_same_action_overlap") {
+		if err := ossBucketLifecyclePut(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketLifecycle", AlibabaCloudSdkGoERROR)
+		}
+		ossServiceV2 := OssServiceV2{client}
+		stateConf := BuildStateConf([]string{}, []string{"#CHECKSET"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ossServiceV2.OssBucketLifecycleStateRefreshFunc(d.Id(), "#$.Rule", []string{}))
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+	}
+
+	return resourceAliCloudOssBucketLifecycleRead(d, meta)
+}
+
+func resourceAliCloudOssBucketLifecycleDelete(d *schema.ResourceData, meta interface{}) error {
+
+	client := meta.(*connectivity.AliyunClient)
+	action := fmt.Sprintf("/?lifecycle")
+	var request map[string]interface{}
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	var err error
+	request = make(map[string]interface{})
+	hostMap["bucket"] = StringPointer(d.Id())
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("DELETE", "2019-05-17", "DeleteBucketLifecycle", action), query, nil, nil, hostMap, false)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+
+	if err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket", "NoSuchLifecycle"}) || NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+
+	return nil
+}
+
+// ossBucketLifecyclePut replaces the whole lifecycle configuration of the bucket with the configured rules.
+func ossBucketLifecyclePut(d *schema.ResourceData, client *connectivity.AliyunClient, timeout time.Duration) error {
+	action := fmt.Sprintf("/?lifecycle")
+	var response map[string]interface{}
+	query := make(map[string]*string)
+	headers := make(map[string]*string)
+	hostMap := make(map[string]*string)
+	hostMap["bucket"] = StringPointer(d.Id())
+
+	rules, err := expandOssBucketLifecycleRules(d.Get("rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+	request := map[string]interface{}{
+		"LifecycleConfiguration": map[string]interface{}{
+			"Rule": rules,
+		},
+	}
+	if d.Get("allow_same_action_overlap").(bool) {
+		headers["x-oss-allow-same-action-overlap"] = StringPointer("true")
+	}
+
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = client.Do("Oss", xmlParam("PUT", "2019-05-17", "PutBucketLifecycle", action), query, request, headers, hostMap, false)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return err
+}
+
+// expandOssBucketLifecycleRules converts the rule blocks to the LifecycleConfiguration rules, and checks the
+// constraints which can not be expressed by the schema.
+func expandOssBucketLifecycleRules(rules []interface{}) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(rules))
+	for index, ruleRaw := range rules {
+		rule, _ := ruleRaw.(map[string]interface{})
+		if rule == nil {
+			continue
+		}
+		ruleMap := map[string]interface{}{
+			"Prefix": rule["prefix"],
+			"Status": rule["status"],
+		}
+		if v, ok := rule["id"].(string); ok && v != "" {
+			ruleMap["ID"] = v
+		}
+		actions := 0
+
+		if tags, ok := rule["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+			tagMaps := make([]interface{}, 0, len(tags))
+			for key, value := range tags {
+				tagMaps = append(tagMaps, map[string]interface{}{"Key": key, "Value": value})
+			}
+			ruleMap["Tag"] = tagMaps
+		}
+
+		if filter := ossBucketLifecycleFirstBlock(rule["filter"]); filter != nil {
+			filterMap := make(map[string]interface{})
+			if v, ok := filter["object_size_greater_than"].(int); ok && v > 0 {
+				filterMap["ObjectSizeGreaterThan"] = v
+			}
+			if v, ok := filter["object_size_less_than"].(int); ok && v > 0 {
+				filterMap["ObjectSizeLessThan"] = v
+			}
+			nots := make([]interface{}, 0)
+			for _, notRaw := range convertToInterfaceArray(filter["not"]) {
+				not, _ := notRaw.(map[string]interface{})
+				if not == nil {
+					continue
+				}
+				notMap := map[string]interface{}{"Prefix": not["prefix"]}
+				if tag := ossBucketLifecycleFirstBlock(not["tag"]); tag != nil {
+					notMap["Tag"] = map[string]interface{}{"Key": tag["key"], "Value": tag["value"]}
+				}
+				nots = append(nots, notMap)
+			}
+			if len(nots) > 0 {
+				filterMap["Not"] = nots
+			}
+			ruleMap["Filter"] = filterMap
+		}
+
+		if expiration := ossBucketLifecycleFirstBlock(rule["expiration"]); expiration != nil {
+			expirationMap, err := expandOssBucketLifecycleTime(expiration)
+			if err != nil {
+				return nil, fmt.Errorf("rule.%d.expiration: %s", index, err)
+			}
+			if v, ok := expiration["expired_object_delete_marker"].(bool); ok && v {
+				if len(expirationMap) > 0 {
+					return nil, fmt.Errorf("rule.%d.expiration: 'expired_object_delete_marker' conflicts with 'days' and 'created_before_date'", index)
+				}
+				expirationMap["ExpiredObjectDeleteMarker"] = true
+			} else if len(expirationMap) == 0 {
+				return nil, fmt.Errorf("rule.%d.expiration: one of 'days', 'created_before_date' and 'expired_object_delete_marker' must be set", index)
+			}
+			ruleMap["Expiration"] = expirationMap
+			actions++
+		}
+
+		transitions := make([]interface{}, 0)
+		for i, transitionRaw := range convertToInterfaceArray(rule["transition"]) {
+			transition, _ := transitionRaw.(map[string]interface{})
+			if transition == nil {
+				continue
+			}
+			transitionMap, err := expandOssBucketLifecycleTime(transition)
+			if err != nil {
+				return nil, fmt.Errorf("rule.%d.transition.%d: %s", index, i, err)
+			}
+			if len(transitionMap) == 0 {
+				return nil, fmt.Errorf("rule.%d.transition.%d: one of 'days' and 'created_before_date' must be set", index, i)
+			}
+			transitionMap["StorageClass"] = transition["storage_class"]
+			if err := expandOssBucketLifecycleAccessTime(transition, transitionMap); err != nil {
+				return nil, fmt.Errorf("rule.%d.transition.%d: %s", index, i, err)
+			}
+			transitions = append(transitions, transitionMap)
+		}
+		if len(transitions) > 0 {
+			ruleMap["Transition"] = transitions
+			actions++
+		}
+
+		if abortMultipartUpload := ossBucketLifecycleFirstBlock(rule["abort_multipart_upload"]); abortMultipartUpload != nil {
+			abortMultipartUploadMap, err := expandOssBucketLifecycleTime(abortMultipartUpload)
+			if err != nil {
+				return nil, fmt.Errorf("rule.%d.abort_multipart_upload: %s", index, err)
+			}
+			if len(abortMultipartUploadMap) == 0 {
+				return nil, fmt.Errorf("rule.%d.abort_multipart_upload: one of 'days' and 'created_before_date' must be set", index)
+			}
+			ruleMap["AbortMultipartUpload"] = abortMultipartUploadMap
+			actions++
+		}
+
+		if noncurrentVersionExpiration := ossBucketLifecycleFirstBlock(rule["noncurrent_version_expiration"]); noncurrentVersionExpiration != nil {
+			ruleMap["NoncurrentVersionExpiration"] = map[string]interface{}{
+				"NoncurrentDays": noncurrentVersionExpiration["noncurrent_days"],
+			}
+			actions++
+		}
+
+		noncurrentVersionTransitions := make([]interface{}, 0)
+		for i, transitionRaw := range convertToInterfaceArray(rule["noncurrent_version_transition"]) {
+			transition, _ := transitionRaw.(map[string]interface{})
+			if transition == nil {
+				continue
+			}
+			transitionMap := map[string]interface{}{
+				"NoncurrentDays": transition["noncurrent_days"],
+				"StorageClass":   transition["storage_class"],
+			}
+			if err := expandOssBucketLifecycleAccessTime(transition, transitionMap); err != nil {
+				return nil, fmt.Errorf("rule.%d.noncurrent_version_transition.%d: %s", index, i, err)
+			}
+			noncurrentVersionTransitions = append(noncurrentVersionTransitions, transitionMap)
+		}
+		if len(noncurrentVersionTransitions) > 0 {
+			ruleMap["NoncurrentVersionTransition"] = noncurrentVersionTransitions
+			actions++
+		}
+
+		if actions == 0 {
+			return nil, fmt.Errorf("rule.%d: at least one of 'expiration', 'transition', 'abort_multipart_upload', 'noncurrent_version_expiration' and 'noncurrent_version_transition' must be set", index)
+		}
+		result = append(result, ruleMap)
+	}
+	return result, nil
+}
+
+// expandOssBucketLifecycleTime returns the Days or CreatedBeforeDate of an action. At most one of them can be set.
+func expandOssBucketLifecycleTime(block map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	days, _ := block["days"].(int)
+	createdBeforeDate, _ := block["created_before_date"].(string)
+	if days > 0 && createdBeforeDate != "" {
+		return nil, fmt.Errorf("'days' conflicts with 'created_before_date'")
+	}
+	if days > 0 {
+		result["Days"] = days
+	}
+	if createdBeforeDate != "" {
+		result["CreatedBeforeDate"] = fmt.Sprintf("%sT00:00:00.000Z", createdBeforeDate)
+	}
+	return result, nil
+}
+
+// expandOssBucketLifecycleAccessTime sets the flags of the transitions based on the last access time.
+func expandOssBucketLifecycleAccessTime(block map[string]interface{}, result map[string]interface{}) error {
+	isAccessTime, _ := block["is_access_time"].(bool)
+	returnToStdWhenVisit, _ := block["return_to_std_when_visit"].(bool)
+	if !isAccessTime {
+		if returnToStdWhenVisit {
+			return fmt.Errorf("'return_to_std_when_visit' can only be set when 'is_access_time' is true")
+		}
+		return nil
+	}
+	result["IsAccessTime"] = true
+	result["ReturnToStdWhenVisit"] = returnToStdWhenVisit
+	if v, ok := block["allow_small_file"].(bool); ok && v {
+		result["AllowSmallFile"] = true
+	}
+	return nil
+}
+
+// flattenOssBucketLifecycleRules converts the rules returned by GetBucketLifecycle to the rule blocks.
+func flattenOssBucketLifecycleRules(rulesRaw interface{}) []map[string]interface{} {
+	ruleMaps := make([]map[string]interface{}, 0)
+	for _, ruleChildRaw := range convertToInterfaceArray(rulesRaw) {
+		ruleChild, _ := ruleChildRaw.(map[string]interface{})
+		if ruleChild == nil {
+			continue
+		}
+		ruleMap := map[string]interface{}{
+			"id":     ruleChild["ID"],
+			"prefix": ruleChild["Prefix"],
+			"status": ruleChild["Status"],
+		}
+
+		tags := make(map[string]interface{})
+		for _, tagRaw := range convertToInterfaceArray(ruleChild["Tag"]) {
+			if tag, ok := tagRaw.(map[string]interface{}); ok {
+				tags[fmt.Sprint(tag["Key"])] = tag["Value"]
+			}
+		}
+		if len(tags) > 0 {
+			ruleMap["tags"] = tags
+		}
+
+		if filter, ok := ruleChild["Filter"].(map[string]interface{}); ok {
+			filterMap := map[string]interface{}{
+				"object_size_greater_than": formatInt(filter["ObjectSizeGreaterThan"]),
+				"object_size_less_than":    formatInt(filter["ObjectSizeLessThan"]),
+			}
+			notMaps := make([]map[string]interface{}, 0)
+			for _, notRaw := range convertToInterfaceArray(filter["Not"]) {
+				not, _ := notRaw.(map[string]interface{})
+				if not == nil {
+					continue
+				}
+				notMap := map[string]interface{}{"prefix": not["Prefix"]}
+				if tag, ok := not["Tag"].(map[string]interface{}); ok {
+					notMap["tag"] = []map[string]interface{}{{"key": tag["Key"], "value": tag["Value"]}}
+				}
+				notMaps = append(notMaps, notMap)
+			}
+			filterMap["not"] = notMaps
+			ruleMap["filter"] = []map[string]interface{}{filterMap}
+		}
+
+		if expiration, ok := ruleChild["Expiration"].(map[string]interface{}); ok {
+			expirationMap := flattenOssBucketLifecycleTime(expiration)
+			expirationMap["expired_object_delete_marker"] = formatBool(expiration["ExpiredObjectDeleteMarker"])
+			ruleMap["expiration"] = []map[string]interface{}{expirationMap}
+		}
+
+		transitionMaps := make([]map[string]interface{}, 0)
+		for _, transitionRaw := range convertToInterfaceArray(ruleChild["Transition"]) {
+			transition, _ := transitionRaw.(map[string]interface{})
+			if transition == nil {
+				continue
+			}
+			transitionMap := flattenOssBucketLifecycleTime(transition)
+			transitionMap["storage_class"] = transition["StorageClass"]
+			transitionMap["is_access_time"] = formatBool(transition["IsAccessTime"])
+			transitionMap["return_to_std_when_visit"] = formatBool(transition["ReturnToStdWhenVisit"])
+			transitionMap["allow_small_file"] = formatBool(transition["AllowSmallFile"])
+			transitionMaps = append(transitionMaps, transitionMap)
+		}
+		ruleMap["transition"] = transitionMaps
+
+		if abortMultipartUpload, ok := ruleChild["AbortMultipartUpload"].(map[string]interface{}); ok {
+			ruleMap["abort_multipart_upload"] = []map[string]interface{}{flattenOssBucketLifecycleTime(abortMultipartUpload)}
+		}
+
+		if noncurrentVersionExpiration, ok := ruleChild["NoncurrentVersionExpiration"].(map[string]interface{}); ok {
+			ruleMap["noncurrent_version_expiration"] = []map[string]interface{}{{"noncurrent_days": formatInt(noncurrentVersionExpiration["NoncurrentDays"])}}
+		}
+
+		noncurrentVersionTransitionMaps := make([]map[string]interface{}, 0)
+		for _, transitionRaw := range convertToInterfaceArray(ruleChild["NoncurrentVersionTransition"]) {
+			transition, _ := transitionRaw.(map[string]interface{})
+			if transition == nil {
+				continue
+			}
+			noncurrentVersionTransitionMaps = append(noncurrentVersionTransitionMaps, map[string]interface{}{
+				"noncurrent_days":          formatInt(transition["NoncurrentDays"]),
+				"storage_class":            transition["StorageClass"],
+				"is_access_time":           formatBool(transition["IsAccessTime"]),
+				"return_to_std_when_visit": formatBool(transition["ReturnToStdWhenVisit"]),
+				"allow_small_file":         formatBool(transition["AllowSmallFile"]),
+			})
+		}
+		ruleMap["noncurrent_version_transition"] = noncurrentVersionTransitionMaps
+
+		ruleMaps = append(ruleMaps, ruleMap)
+	}
+	return ruleMaps
+}
+
+// flattenOssBucketLifecycleTime returns the days or created_before_date of an action, and the date is truncated to the day.
+func flattenOssBucketLifecycleTime(block map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"days": formatInt(block["Days"]),
+	}
+	if v, ok := block["CreatedBeforeDate"].(string); ok && v != "" {
+		result["created_before_date"] = strings.SplitN(v, "T", 2)[0]
+	}
+	return result
+}
+
+func ossBucketLifecycleFirstBlock(v interface{}) map[string]interface{} {
+	blocks := convertToInterfaceArray(v)
+	if len(blocks) == 0 {
+		return nil
+	}
+	block, _ := blocks[0].(map[string]interface{})
+	return block
+}
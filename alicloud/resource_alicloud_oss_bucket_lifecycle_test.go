@@ -0,0 +1,256 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudOssBucketLifecycle_basic(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_oss_bucket_lifecycle.default"
+	ra := resourceAttrInit(resourceId, AlicloudOssBucketLifecycleMap)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &OssServiceV2{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeOssBucketLifecycle")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%sossbucketlifecycle%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudOssBucketLifecycleBasicDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"bucket": "${alicloud_oss_bucket.CreateBucket.bucket}",
+					"rule": []map[string]interface{}{
+						{
+							"id":     "expire-logs",
+							"prefix": "logs/",
+							"status": "Enabled",
+							"expiration": []map[string]interface{}{
+								{
+									"days": "30",
+								},
+							},
+							"abort_multipart_upload": []map[string]interface{}{
+								{
+									"days": "7",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"bucket":                               CHECKSET,
+						"rule.#":                               "1",
+						"rule.0.id":                            "expire-logs",
+						"rule.0.expiration.0.days":             "30",
+						"rule.0.abort_multipart_upload.#":      "1",
+						"rule.0.abort_multipart_upload.0.days": "7",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"allow_same_action_overlap": "true",
+					"rule": []map[string]interface{}{
+						{
+							"id":     "expire-logs",
+							"prefix": "logs/",
+							"status": "Disabled",
+							"expiration": []map[string]interface{}{
+								{
+									"created_before_date": "2024-01-01",
+								},
+							},
+						},
+						{
+							"id":     "archive-large",
+							"prefix": "data/",
+							"status": "Enabled",
+							"tags": map[string]string{
+								"team": "storage",
+							},
+							"filter": []map[string]interface{}{
+								{
+									"object_size_greater_than": "1048576",
+									"not": []map[string]interface{}{
+										{
+											"prefix": "data/hot/",
+										},
+									},
+								},
+							},
+							"transition": []map[string]interface{}{
+								{
+									"days":          "30",
+									"storage_class": "IA",
+								},
+								{
+									"days":          "180",
+									"storage_class": "Archive",
+								},
+							},
+							"noncurrent_version_expiration": []map[string]interface{}{
+								{
+									"noncurrent_days": "365",
+								},
+							},
+							"noncurrent_version_transition": []map[string]interface{}{
+								{
+									"noncurrent_days": "30",
+									"storage_class":   "IA",
+								},
+							},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"allow_same_action_overlap": "true",
+						"rule.#":                    "2",
+						"rule.0.status":             "Disabled",
+						"rule.0.expiration.0.created_before_date": "2024-01-01",
+						"rule.1.tags.%": "1",
+						"rule.1.filter.0.object_size_greater_than":               "1048576",
+						"rule.1.filter.0.not.#":                                  "1",
+						"rule.1.transition.#":                                    "2",
+						"rule.1.noncurrent_version_expiration.0.noncurrent_days": "365",
+						"rule.1.noncurrent_version_transition.#":                 "1",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_same_action_overlap"},
+			},
+		},
+	})
+}
+
+var AlicloudOssBucketLifecycleMap = map[string]string{
+	"allow_same_action_overlap": "false",
+}
+
+func AlicloudOssBucketLifecycleBasicDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+    default = "%s"
+}
+
+resource "alicloud_oss_bucket" "CreateBucket" {
+  storage_class = "Standard"
+  bucket        = var.name
+  lifecycle {
+    ignore_changes = [
+      lifecycle_rule,
+    ]
+  }
+}
+
+resource "alicloud_oss_bucket_versioning" "default" {
+  bucket = alicloud_oss_bucket.CreateBucket.bucket
+  status = "Enabled"
+}
+`, name)
+}
+
+func TestUnitAliCloudOssBucketLifecycleRules(t *testing.T) {
+	r := resourceAliCloudOssBucketLifecycle()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"bucket": "tf-test",
+		"rule": []interface{}{
+			map[string]interface{}{
+				"id":     "rule1",
+				"prefix": "logs/",
+				"status": "Enabled",
+				"tags":   map[string]interface{}{"team": "storage"},
+				"filter": []interface{}{
+					map[string]interface{}{
+						"object_size_less_than": 1024,
+						"not": []interface{}{
+							map[string]interface{}{
+								"prefix": "logs/keep/",
+								"tag":    []interface{}{map[string]interface{}{"key": "keep", "value": "true"}},
+							},
+						},
+					},
+				},
+				"expiration": []interface{}{map[string]interface{}{"created_before_date": "2024-01-01"}},
+				"transition": []interface{}{
+					map[string]interface{}{"days": 30, "storage_class": "IA", "is_access_time": true, "return_to_std_when_visit": true},
+				},
+				"noncurrent_version_transition": []interface{}{
+					map[string]interface{}{"noncurrent_days": 10, "storage_class": "Archive"},
+				},
+			},
+		},
+	})
+
+	rules, err := expandOssBucketLifecycleRules(d.Get("rule").([]interface{}))
+	assert.Nil(t, err)
+	assert.Len(t, rules, 1)
+	rule := rules[0].(map[string]interface{})
+	assert.Equal(t, "rule1", rule["ID"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"Key": "team", "Value": "storage"}}, rule["Tag"])
+	assert.Equal(t, map[string]interface{}{
+		"ObjectSizeLessThan": 1024,
+		"Not": []interface{}{map[string]interface{}{
+			"Prefix": "logs/keep/",
+			"Tag":    map[string]interface{}{"Key": "keep", "Value": "true"},
+		}},
+	}, rule["Filter"])
+	assert.Equal(t, map[string]interface{}{"CreatedBeforeDate": "2024-01-01T00:00:00.000Z"}, rule["Expiration"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"Days": 30, "StorageClass": "IA", "IsAccessTime": true, "ReturnToStdWhenVisit": true,
+	}}, rule["Transition"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"NoncurrentDays": 10, "StorageClass": "Archive"}}, rule["NoncurrentVersionTransition"])
+
+	// the rules are returned by the xml response of GetBucketLifecycle, and a single element is not a list
+	d.Set("rule", flattenOssBucketLifecycleRules(map[string]interface{}{
+		"ID":     "rule1",
+		"Prefix": "logs/",
+		"Status": "Enabled",
+		"Tag":    map[string]interface{}{"Key": "team", "Value": "storage"},
+		"Filter": map[string]interface{}{
+			"ObjectSizeLessThan": "1024",
+			"Not":                map[string]interface{}{"Prefix": "logs/keep/", "Tag": map[string]interface{}{"Key": "keep", "Value": "true"}},
+		},
+		"Expiration": map[string]interface{}{"CreatedBeforeDate": "2024-01-01T00:00:00.000Z"},
+		"Transition": []interface{}{
+			map[string]interface{}{"Days": "30", "StorageClass": "IA", "IsAccessTime": "true", "ReturnToStdWhenVisit": "true"},
+		},
+		"NoncurrentVersionTransition": map[string]interface{}{"NoncurrentDays": "10", "StorageClass": "Archive"},
+	}))
+	flattened, err := expandOssBucketLifecycleRules(d.Get("rule").([]interface{}))
+	assert.Nil(t, err)
+	assert.Equal(t, rules, flattened)
+
+	invalid := []map[string]interface{}{
+		{"status": "Enabled"},
+		{"status": "Enabled", "expiration": []interface{}{map[string]interface{}{"days": 1, "created_before_date": "2024-01-01"}}},
+		{"status": "Enabled", "expiration": []interface{}{map[string]interface{}{"days": 1, "expired_object_delete_marker": true}}},
+		{"status": "Enabled", "abort_multipart_upload": []interface{}{map[string]interface{}{}}},
+		{"status": "Enabled", "transition": []interface{}{map[string]interface{}{"days": 1, "storage_class": "IA", "return_to_std_when_visit": true}}},
+	}
+	for _, rule := range invalid {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"bucket": "tf-test", "rule": []interface{}{rule}})
+		_, err := expandOssBucketLifecycleRules(d.Get("rule").([]interface{}))
+		assert.NotNil(t, err, fmt.Sprint(rule))
+	}
+}
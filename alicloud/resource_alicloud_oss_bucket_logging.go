@@ -12,13 +12,11 @@ import (
 
 func resourceAliCloudOssBucketLogging() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketLoggingCreate,
-		Read:   resourceAliCloudOssBucketLoggingRead,
-		Update: resourceAliCloudOssBucketLoggingUpdate,
-		Delete: resourceAliCloudOssBucketLoggingDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketLoggingCreate,
+		Read:     resourceAliCloudOssBucketLoggingRead,
+		Update:   resourceAliCloudOssBucketLoggingUpdate,
+		Delete:   resourceAliCloudOssBucketLoggingDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -13,12 +13,10 @@ import (
 
 func resourceAliCloudOssBucketMetaQuery() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketMetaQueryCreate,
-		Read:   resourceAliCloudOssBucketMetaQueryRead,
-		Delete: resourceAliCloudOssBucketMetaQueryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketMetaQueryCreate,
+		Read:     resourceAliCloudOssBucketMetaQueryRead,
+		Delete:   resourceAliCloudOssBucketMetaQueryDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
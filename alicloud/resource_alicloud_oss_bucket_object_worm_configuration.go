@@ -12,13 +12,11 @@ import (
 
 func resourceAliCloudOssBucketObjectWormConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketObjectWormConfigurationCreate,
-		Read:   resourceAliCloudOssBucketObjectWormConfigurationRead,
-		Update: resourceAliCloudOssBucketObjectWormConfigurationUpdate,
-		Delete: resourceAliCloudOssBucketObjectWormConfigurationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketObjectWormConfigurationCreate,
+		Read:     resourceAliCloudOssBucketObjectWormConfigurationRead,
+		Update:   resourceAliCloudOssBucketObjectWormConfigurationUpdate,
+		Delete:   resourceAliCloudOssBucketObjectWormConfigurationDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -0,0 +1,560 @@
+package alicloud
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/mitchellh/go-homedir"
+)
+
+func resourceAliCloudOssBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAliCloudOssBucketObjectsSyncCreate,
+		Read:          resourceAliCloudOssBucketObjectsSyncRead,
+		Update:        resourceAliCloudOssBucketObjectsSyncUpdate,
+		Delete:        resourceAliCloudOssBucketObjectsSyncDelete,
+		CustomizeDiff: resourceAliCloudOssBucketObjectsSyncCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"include": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: IntBetween(1, 100),
+			},
+			"manifest_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliCloudOssBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("bucket").(string), d.Get("key_prefix").(string)))
+	if err := ossBucketObjectsSyncApply(d, meta, false); err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceAliCloudOssBucketObjectsSyncRead(d, meta)
+}
+
+func resourceAliCloudOssBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	syncer, err := newOssBucketObjectsSyncerFromResource(d, meta)
+	if err != nil {
+		return err
+	}
+	remote, err := syncer.listRemote()
+	if err != nil {
+		if !d.IsNewResource() && IsExpectedErrors(err, []string{"NoSuchBucket"}) {
+			log.Printf("[DEBUG] Resource alicloud_oss_bucket_objects_sync ListObjectsV2 Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	d.Set("object_count", len(remote))
+
+	// Without the local directory, e.g. when the configuration is planned on another machine, the drift can not be
+	// detected and the manifest hash in the state is kept.
+	files, err := syncer.buildManifest()
+	if err != nil {
+		log.Printf("[WARN] Skipping the drift detection of alicloud_oss_bucket_objects_sync %s: %s", d.Id(), err)
+		return nil
+	}
+	uploads, deletes := syncer.diff(files, remote)
+	if len(uploads) > 0 || len(deletes) > 0 {
+		log.Printf("[DEBUG] alicloud_oss_bucket_objects_sync %s drifted: %d objects are changed or missing and %d objects are unmanaged.", d.Id(), len(uploads), len(deletes))
+		d.Set("manifest_hash", "")
+	}
+	return nil
+}
+
+func resourceAliCloudOssBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	// the object metadata is not returned by the listing, so all of the objects are uploaded again once it changes
+	if err := ossBucketObjectsSyncApply(d, meta, d.HasChange("content_rule")); err != nil {
+		return err
+	}
+	return resourceAliCloudOssBucketObjectsSyncRead(d, meta)
+}
+
+func resourceAliCloudOssBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	syncer, err := newOssBucketObjectsSyncerFromResource(d, meta)
+	if err != nil {
+		return err
+	}
+	remote, err := syncer.listRemote()
+	if err != nil {
+		if IsExpectedErrors(err, []string{"NoSuchBucket"}) {
+			return nil
+		}
+		return err
+	}
+	keys := make([]string, 0, len(remote))
+	for key := range remote {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return syncer.delete(keys)
+}
+
+func resourceAliCloudOssBucketObjectsSyncCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"source_dir", "key_prefix", "include", "exclude", "content_rule"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("manifest_hash")
+		}
+	}
+	syncer := &ossBucketObjectsSyncer{}
+	if err := syncer.configure(d.Get); err != nil {
+		return err
+	}
+	files, err := syncer.buildManifest()
+	if err != nil {
+		return WrapError(err)
+	}
+	if hash := ossBucketObjectsSyncManifestHash(files); hash != d.Get("manifest_hash").(string) {
+		return d.SetNew("manifest_hash", hash)
+	}
+	return nil
+}
+
+func ossBucketObjectsSyncApply(d *schema.ResourceData, meta interface{}, uploadAll bool) error {
+	syncer, err := newOssBucketObjectsSyncerFromResource(d, meta)
+	if err != nil {
+		return err
+	}
+	files, err := syncer.buildManifest()
+	if err != nil {
+		return WrapError(err)
+	}
+	remote, err := syncer.listRemote()
+	if err != nil {
+		return err
+	}
+	uploads, deletes := syncer.diff(files, remote)
+	if uploadAll {
+		uploads = files
+	}
+	log.Printf("[INFO] Syncing %s to the OSS bucket %s: %d objects to upload, %d objects to delete, %d objects unchanged.",
+		syncer.sourceDir, syncer.name, len(uploads), len(deletes), len(files)-len(uploads))
+	if err := syncer.upload(uploads); err != nil {
+		return err
+	}
+	if err := syncer.delete(deletes); err != nil {
+		return err
+	}
+	d.Set("manifest_hash", ossBucketObjectsSyncManifestHash(files))
+	return nil
+}
+
+// ossObjectsSyncAPI is the part of oss.Bucket used to sync the objects.
+type ossObjectsSyncAPI interface {
+	ListObjectsV2(options ...oss.Option) (oss.ListObjectsResultV2, error)
+	PutObjectFromFile(objectKey, filePath string, options ...oss.Option) error
+	DeleteObjects(objectKeys []string, options ...oss.Option) (oss.DeleteObjectsResult, error)
+}
+
+// ossObjectsSyncFile is a local file and the object it is uploaded to.
+type ossObjectsSyncFile struct {
+	Path         string
+	Key          string
+	MD5          string
+	ContentType  string
+	CacheControl string
+}
+
+type ossObjectsSyncRule struct {
+	pattern      *regexp.Regexp
+	contentType  string
+	cacheControl string
+}
+
+// ossBucketObjectsSyncer syncs the files of a local directory to the objects under a key prefix. Only the objects
+// whose keys, relative to the prefix, match the include and exclude patterns are managed.
+type ossBucketObjectsSyncer struct {
+	name        string
+	bucket      ossObjectsSyncAPI
+	sourceDir   string
+	keyPrefix   string
+	include     []*regexp.Regexp
+	exclude     []*regexp.Regexp
+	rules       []ossObjectsSyncRule
+	concurrency int
+}
+
+func newOssBucketObjectsSyncerFromResource(d *schema.ResourceData, meta interface{}) (*ossBucketObjectsSyncer, error) {
+	client := meta.(*connectivity.AliyunClient)
+	syncer := &ossBucketObjectsSyncer{name: d.Get("bucket").(string)}
+	if err := syncer.configure(d.Get); err != nil {
+		return nil, WrapError(err)
+	}
+	var requestInfo *oss.Client
+	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+		requestInfo = ossClient
+		return ossClient.Bucket(syncer.name)
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AliyunOssGoSdk)
+	}
+	addDebug("Bucket", raw, requestInfo, map[string]string{"bucketName": syncer.name})
+	syncer.bucket = raw.(*oss.Bucket)
+	return syncer, nil
+}
+
+// configure reads the arguments by get, which is the Get of either schema.ResourceData or schema.ResourceDiff.
+func (s *ossBucketObjectsSyncer) configure(get func(string) interface{}) (err error) {
+	if s.sourceDir, err = homedir.Expand(get("source_dir").(string)); err != nil {
+		return err
+	}
+	s.keyPrefix = get("key_prefix").(string)
+	if s.include, err = ossObjectsSyncCompileGlobs(get("include").([]interface{})); err != nil {
+		return err
+	}
+	if s.exclude, err = ossObjectsSyncCompileGlobs(get("exclude").([]interface{})); err != nil {
+		return err
+	}
+	s.rules = nil
+	for _, raw := range get("content_rule").([]interface{}) {
+		rule, _ := raw.(map[string]interface{})
+		if rule == nil {
+			continue
+		}
+		pattern, err := ossObjectsSyncCompileGlob(rule["pattern"].(string))
+		if err != nil {
+			return err
+		}
+		s.rules = append(s.rules, ossObjectsSyncRule{
+			pattern:      pattern,
+			contentType:  rule["content_type"].(string),
+			cacheControl: rule["cache_control"].(string),
+		})
+	}
+	if v, ok := get("concurrency").(int); ok && v > 0 {
+		s.concurrency = v
+	}
+	return nil
+}
+
+func (s *ossBucketObjectsSyncer) managed(relative string) bool {
+	if len(s.include) > 0 && !ossObjectsSyncMatchAny(s.include, relative) {
+		return false
+	}
+	return !ossObjectsSyncMatchAny(s.exclude, relative)
+}
+
+// buildManifest walks the source directory and returns the managed files sorted by key.
+func (s *ossBucketObjectsSyncer) buildManifest() ([]ossObjectsSyncFile, error) {
+	info, err := os.Stat(s.sourceDir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("source_dir %s is not a directory", s.sourceDir)
+	}
+	files := make([]ossObjectsSyncFile, 0)
+	err = filepath.Walk(s.sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relative, err := filepath.Rel(s.sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relative = filepath.ToSlash(relative)
+		if !s.managed(relative) {
+			return nil
+		}
+		md5sum, err := ossObjectsSyncFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+		file := ossObjectsSyncFile{
+			Path:        filePath,
+			Key:         s.keyPrefix + relative,
+			MD5:         md5sum,
+			ContentType: mime.TypeByExtension(path.Ext(relative)),
+		}
+		// the later rules override the earlier ones
+		for _, rule := range s.rules {
+			if !rule.pattern.MatchString(relative) {
+				continue
+			}
+			if rule.contentType != "" {
+				file.ContentType = rule.contentType
+			}
+			if rule.cacheControl != "" {
+				file.CacheControl = rule.cacheControl
+			}
+		}
+		files = append(files, file)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+	return files, nil
+}
+
+// listRemote returns the ETags of the managed objects by their keys.
+func (s *ossBucketObjectsSyncer) listRemote() (map[string]string, error) {
+	objects := make(map[string]string)
+	token := ""
+	for {
+		options := []oss.Option{oss.Prefix(s.keyPrefix), oss.MaxKeys(OssDeleteObjectsBatchSize)}
+		if token != "" {
+			options = append(options, oss.ContinuationToken(token))
+		}
+		result, err := s.bucket.ListObjectsV2(options...)
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, s.name, "ListObjectsV2", AliyunOssGoSdk)
+		}
+		for _, object := range result.Objects {
+			relative := strings.TrimPrefix(object.Key, s.keyPrefix)
+			if relative == "" || strings.HasSuffix(relative, "/") || !s.managed(relative) {
+				continue
+			}
+			objects[object.Key] = strings.ToLower(strings.Trim(object.ETag, `"`))
+		}
+		if !result.IsTruncated {
+			return objects, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// diff returns the files whose objects are missing or have different content, and the keys of the managed objects
+// which are not in the directory.
+func (s *ossBucketObjectsSyncer) diff(files []ossObjectsSyncFile, remote map[string]string) ([]ossObjectsSyncFile, []string) {
+	uploads := make([]ossObjectsSyncFile, 0)
+	local := make(map[string]bool, len(files))
+	for _, file := range files {
+		local[file.Key] = true
+		if etag, ok := remote[file.Key]; !ok || etag != file.MD5 {
+			uploads = append(uploads, file)
+		}
+	}
+	deletes := make([]string, 0)
+	for key := range remote {
+		if !local[key] {
+			deletes = append(deletes, key)
+		}
+	}
+	sort.Strings(deletes)
+	return uploads, deletes
+}
+
+// upload puts the files in parallel, and stops at the first failure.
+func (s *ossBucketObjectsSyncer) upload(files []ossObjectsSyncFile) error {
+	concurrency := s.concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	jobs := make(chan ossObjectsSyncFile)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var failure error
+	uploaded := 0
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range jobs {
+				options := []oss.Option{oss.ContentMD5(ossObjectsSyncBase64MD5(file.MD5))}
+				if file.ContentType != "" {
+					options = append(options, oss.ContentType(file.ContentType))
+				}
+				if file.CacheControl != "" {
+					options = append(options, oss.CacheControl(file.CacheControl))
+				}
+				err := s.bucket.PutObjectFromFile(file.Key, file.Path, options...)
+				mutex.Lock()
+				if err != nil && failure == nil {
+					failure = WrapErrorf(err, DefaultErrorMsg, s.name, "PutObject "+file.Key, AliyunOssGoSdk)
+				}
+				if err == nil {
+					uploaded++
+					if uploaded%1000 == 0 {
+						log.Printf("[INFO] Syncing to the OSS bucket %s: %d of %d objects uploaded.", s.name, uploaded, len(files))
+					}
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, file := range files {
+		mutex.Lock()
+		failed := failure != nil
+		mutex.Unlock()
+		if failed {
+			break
+		}
+		jobs <- file
+	}
+	close(jobs)
+	wg.Wait()
+	return failure
+}
+
+// delete removes the objects in batches of OssDeleteObjectsBatchSize keys.
+func (s *ossBucketObjectsSyncer) delete(keys []string) error {
+	for start := 0; start < len(keys); start += OssDeleteObjectsBatchSize {
+		end := start + OssDeleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if _, err := s.bucket.DeleteObjects(keys[start:end], oss.DeleteObjectsQuiet(true)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, s.name, "DeleteObjects", AliyunOssGoSdk)
+		}
+	}
+	return nil
+}
+
+// ossBucketObjectsSyncManifestHash is the hash of the keys, contents and metadata of all of the files. It is the only
+// thing stored in the state, so the state does not grow with the number of the files.
+func ossBucketObjectsSyncManifestHash(files []ossObjectsSyncFile) string {
+	hash := sha256.New()
+	for _, file := range files {
+		fmt.Fprintf(hash, "%s\t%s\t%s\t%s\n", file.Key, file.MD5, file.ContentType, file.CacheControl)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func ossObjectsSyncFileMD5(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := md5.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func ossObjectsSyncBase64MD5(md5sum string) string {
+	raw, _ := hex.DecodeString(md5sum)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func ossObjectsSyncCompileGlobs(patterns []interface{}) ([]*regexp.Regexp, error) {
+	result := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := ossObjectsSyncCompileGlob(fmt.Sprint(pattern))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, re)
+	}
+	return result, nil
+}
+
+// ossObjectsSyncCompileGlob converts a glob pattern of the slash separated relative paths to a regular expression.
+// "*" and "?" do not match "/", and "**" matches any number of directories.
+func ossObjectsSyncCompileGlob(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					expr.WriteString("(.*/)?")
+				} else {
+					expr.WriteString(".*")
+				}
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	expr.WriteString("$")
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+	}
+	return re, nil
+}
+
+func ossObjectsSyncMatchAny(patterns []*regexp.Regexp, name string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
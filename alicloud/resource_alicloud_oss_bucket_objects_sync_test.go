@@ -0,0 +1,172 @@
+package alicloud
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/stretchr/testify/assert"
+)
+
+// testOssObjectsSyncBucket is an in-memory bucket keeping the ETags and the headers of the objects.
+type testOssObjectsSyncBucket struct {
+	mutex   sync.Mutex
+	objects map[string]string
+	headers map[string]map[string]interface{}
+}
+
+func newTestOssObjectsSyncBucket() *testOssObjectsSyncBucket {
+	return &testOssObjectsSyncBucket{objects: map[string]string{}, headers: map[string]map[string]interface{}{}}
+}
+
+func (b *testOssObjectsSyncBucket) ListObjectsV2(options ...oss.Option) (result oss.ListObjectsResultV2, err error) {
+	params, _ := oss.GetRawParams(options)
+	prefix, _ := params["prefix"].(string)
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	keys := make([]string, 0)
+	for key := range b.objects {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		result.Objects = append(result.Objects, oss.ObjectProperties{Key: key, ETag: `"` + strings.ToUpper(b.objects[key]) + `"`})
+	}
+	return result, nil
+}
+
+func (b *testOssObjectsSyncBucket) PutObjectFromFile(objectKey, filePath string, options ...oss.Option) error {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	sum := md5.Sum(content)
+	headers := map[string]interface{}{}
+	for _, header := range []string{oss.HTTPHeaderContentType, oss.HTTPHeaderCacheControl, oss.HTTPHeaderContentMD5} {
+		if v, _ := oss.FindOption(options, header, nil); v != nil {
+			headers[header] = v
+		}
+	}
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.objects[objectKey] = hex.EncodeToString(sum[:])
+	b.headers[objectKey] = headers
+	return nil
+}
+
+func (b *testOssObjectsSyncBucket) DeleteObjects(objectKeys []string, options ...oss.Option) (oss.DeleteObjectsResult, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, key := range objectKeys {
+		delete(b.objects, key)
+	}
+	return oss.DeleteObjectsResult{}, nil
+}
+
+func TestUnitAliCloudOssBucketObjectsSync(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-test-oss-sync")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"index.html":          "<html></html>",
+		"assets/app.js":       "console.log(1)",
+		"assets/img/logo.png": "png",
+		"drafts/todo.html":    "draft",
+		".git/config":         "[core]",
+	}
+	for name, content := range files {
+		assert.Nil(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755))
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	bucket := newTestOssObjectsSyncBucket()
+	bucket.objects["site/stale.html"] = "0123"
+	bucket.objects["site/.git/HEAD"] = "4567"
+	bucket.objects["other/keep.html"] = "89ab"
+	config := map[string]interface{}{
+		"source_dir":  dir,
+		"key_prefix":  "site/",
+		"include":     []interface{}{},
+		"exclude":     []interface{}{".git/**", "drafts/*"},
+		"concurrency": 3,
+		"content_rule": []interface{}{
+			map[string]interface{}{"pattern": "**", "content_type": "", "cache_control": "max-age=60"},
+			map[string]interface{}{"pattern": "assets/**", "content_type": "", "cache_control": "max-age=31536000"},
+			map[string]interface{}{"pattern": "**/*.png", "content_type": "image/x-test", "cache_control": ""},
+		},
+	}
+	syncer := &ossBucketObjectsSyncer{name: "tf-test", bucket: bucket}
+	assert.Nil(t, syncer.configure(func(key string) interface{} { return config[key] }))
+
+	manifest, err := syncer.buildManifest()
+	assert.Nil(t, err)
+	keys := make([]string, 0)
+	for _, file := range manifest {
+		keys = append(keys, file.Key)
+	}
+	assert.Equal(t, []string{"site/assets/app.js", "site/assets/img/logo.png", "site/index.html"}, keys)
+	assert.Equal(t, "max-age=31536000", manifest[1].CacheControl)
+	assert.Equal(t, "image/x-test", manifest[1].ContentType)
+	assert.Equal(t, "max-age=60", manifest[2].CacheControl)
+	assert.True(t, strings.HasPrefix(manifest[2].ContentType, "text/html"))
+
+	remote, err := syncer.listRemote()
+	assert.Nil(t, err)
+	uploads, deletes := syncer.diff(manifest, remote)
+	assert.Len(t, uploads, 3)
+	// the excluded objects and the objects out of the prefix are not managed
+	assert.Equal(t, []string{"site/stale.html"}, deletes)
+	assert.Nil(t, syncer.upload(uploads))
+	assert.Nil(t, syncer.delete(deletes))
+	assert.Equal(t, "max-age=60", bucket.headers["site/index.html"][oss.HTTPHeaderCacheControl])
+	assert.Equal(t, "image/x-test", bucket.headers["site/assets/img/logo.png"][oss.HTTPHeaderContentType])
+	assert.NotNil(t, bucket.headers["site/index.html"][oss.HTTPHeaderContentMD5])
+	assert.Contains(t, bucket.objects, "site/.git/HEAD")
+	assert.Contains(t, bucket.objects, "other/keep.html")
+	assert.NotContains(t, bucket.objects, "site/stale.html")
+
+	// only the changed file is uploaded again
+	hash := ossBucketObjectsSyncManifestHash(manifest)
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<html>v2</html>"), 0644))
+	manifest, err = syncer.buildManifest()
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, ossBucketObjectsSyncManifestHash(manifest))
+	remote, err = syncer.listRemote()
+	assert.Nil(t, err)
+	uploads, deletes = syncer.diff(manifest, remote)
+	assert.Len(t, uploads, 1)
+	assert.Equal(t, "site/index.html", uploads[0].Key)
+	assert.Len(t, deletes, 0)
+}
+
+func TestUnitAliCloudOssBucketObjectsSyncGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{"*.html", "index.html", true},
+		{"*.html", "docs/index.html", false},
+		{"**/*.html", "index.html", true},
+		{"**/*.html", "docs/a/index.html", true},
+		{"docs/**", "docs/a/b.txt", true},
+		{"docs/**", "doc/a.txt", false},
+		{"?.txt", "a.txt", true},
+		{"?.txt", "ab.txt", false},
+		{"a+b.txt", "a+b.txt", true},
+	}
+	for _, c := range cases {
+		re, err := ossObjectsSyncCompileGlob(c.pattern)
+		assert.Nil(t, err)
+		assert.Equal(t, c.match, re.MatchString(c.name), c.pattern+" "+c.name)
+	}
+}
@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketOverwriteConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketOverwriteConfigCreate,
-		Read:   resourceAliCloudOssBucketOverwriteConfigRead,
-		Update: resourceAliCloudOssBucketOverwriteConfigUpdate,
-		Delete: resourceAliCloudOssBucketOverwriteConfigDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketOverwriteConfigCreate,
+		Read:     resourceAliCloudOssBucketOverwriteConfigRead,
+		Update:   resourceAliCloudOssBucketOverwriteConfigUpdate,
+		Delete:   resourceAliCloudOssBucketOverwriteConfigDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketPolicyCreate,
-		Read:   resourceAliCloudOssBucketPolicyRead,
-		Update: resourceAliCloudOssBucketPolicyUpdate,
-		Delete: resourceAliCloudOssBucketPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketPolicyCreate,
+		Read:     resourceAliCloudOssBucketPolicyRead,
+		Update:   resourceAliCloudOssBucketPolicyUpdate,
+		Delete:   resourceAliCloudOssBucketPolicyDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketPublicAccessBlock() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketPublicAccessBlockCreate,
-		Read:   resourceAliCloudOssBucketPublicAccessBlockRead,
-		Update: resourceAliCloudOssBucketPublicAccessBlockUpdate,
-		Delete: resourceAliCloudOssBucketPublicAccessBlockDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketPublicAccessBlockCreate,
+		Read:     resourceAliCloudOssBucketPublicAccessBlockRead,
+		Update:   resourceAliCloudOssBucketPublicAccessBlockUpdate,
+		Delete:   resourceAliCloudOssBucketPublicAccessBlockDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketReferer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketRefererCreate,
-		Read:   resourceAliCloudOssBucketRefererRead,
-		Update: resourceAliCloudOssBucketRefererUpdate,
-		Delete: resourceAliCloudOssBucketRefererDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketRefererCreate,
+		Read:     resourceAliCloudOssBucketRefererRead,
+		Update:   resourceAliCloudOssBucketRefererUpdate,
+		Delete:   resourceAliCloudOssBucketRefererDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
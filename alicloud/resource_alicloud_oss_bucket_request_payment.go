@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketRequestPayment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketRequestPaymentCreate,
-		Read:   resourceAliCloudOssBucketRequestPaymentRead,
-		Update: resourceAliCloudOssBucketRequestPaymentUpdate,
-		Delete: resourceAliCloudOssBucketRequestPaymentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketRequestPaymentCreate,
+		Read:     resourceAliCloudOssBucketRequestPaymentRead,
+		Update:   resourceAliCloudOssBucketRequestPaymentUpdate,
+		Delete:   resourceAliCloudOssBucketRequestPaymentDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
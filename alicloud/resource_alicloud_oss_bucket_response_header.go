@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketResponseHeader() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketResponseHeaderCreate,
-		Read:   resourceAliCloudOssBucketResponseHeaderRead,
-		Update: resourceAliCloudOssBucketResponseHeaderUpdate,
-		Delete: resourceAliCloudOssBucketResponseHeaderDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketResponseHeaderCreate,
+		Read:     resourceAliCloudOssBucketResponseHeaderRead,
+		Update:   resourceAliCloudOssBucketResponseHeaderUpdate,
+		Delete:   resourceAliCloudOssBucketResponseHeaderDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
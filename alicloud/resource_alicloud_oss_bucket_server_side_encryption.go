@@ -12,13 +12,11 @@ import (
 
 func resourceAliCloudOssBucketServerSideEncryption() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketServerSideEncryptionCreate,
-		Read:   resourceAliCloudOssBucketServerSideEncryptionRead,
-		Update: resourceAliCloudOssBucketServerSideEncryptionUpdate,
-		Delete: resourceAliCloudOssBucketServerSideEncryptionDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketServerSideEncryptionCreate,
+		Read:     resourceAliCloudOssBucketServerSideEncryptionRead,
+		Update:   resourceAliCloudOssBucketServerSideEncryptionUpdate,
+		Delete:   resourceAliCloudOssBucketServerSideEncryptionDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
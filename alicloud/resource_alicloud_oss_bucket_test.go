@@ -5,12 +5,15 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -2753,3 +2756,152 @@ var ossBucketBasicMap = map[string]string{
 	"creation_date":    CHECKSET,
 	"lifecycle_rule.#": "0",
 }
+
+// testOssBucket is an in-memory bucket whose object versions, multipart uploads and LiveChannels are listed by markers.
+type testOssBucket struct {
+	mutex     sync.Mutex
+	versions  []oss.DeleteObject
+	markers   map[string]bool
+	uploads   []oss.UncompletedUpload
+	channels  []string
+	deleted   map[string]bool
+	maxBatch  int
+	deleteErr error
+}
+
+func newTestOssBucket(objects, uploads, channels int) *testOssBucket {
+	bucket := &testOssBucket{markers: map[string]bool{}, deleted: map[string]bool{}}
+	for i := 0; i < objects; i++ {
+		for v := 0; v < 3; v++ {
+			object := oss.DeleteObject{Key: fmt.Sprintf("object-%05d", i), VersionId: fmt.Sprintf("v%d", v)}
+			bucket.versions = append(bucket.versions, object)
+			// the latest version of every fifth object is a delete marker
+			bucket.markers[object.Key+"/"+object.VersionId] = i%5 == 0 && v == 0
+		}
+	}
+	for i := 0; i < uploads; i++ {
+		bucket.uploads = append(bucket.uploads, oss.UncompletedUpload{Key: fmt.Sprintf("upload-%05d", i), UploadID: fmt.Sprintf("id-%d", i)})
+	}
+	for i := 0; i < channels; i++ {
+		bucket.channels = append(bucket.channels, fmt.Sprintf("channel-%d", i))
+	}
+	return bucket
+}
+
+func (b *testOssBucket) ListObjectVersions(options ...oss.Option) (result oss.ListObjectVersionsResult, err error) {
+	params, _ := oss.GetRawParams(options)
+	keyMarker, versionIdMarker := fmt.Sprint(params["key-marker"]), fmt.Sprint(params["version-id-marker"])
+	maxKeys, _ := strconv.Atoi(fmt.Sprint(params["max-keys"]))
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, object := range b.versions {
+		if keyMarker != "" && (object.Key < keyMarker || object.Key == keyMarker && object.VersionId <= versionIdMarker) {
+			continue
+		}
+		id := object.Key + "/" + object.VersionId
+		if b.deleted[id] {
+			continue
+		}
+		if len(result.ObjectVersions)+len(result.ObjectDeleteMarkers) == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if b.markers[id] {
+			result.ObjectDeleteMarkers = append(result.ObjectDeleteMarkers, oss.ObjectDeleteMarkerProperties{Key: object.Key, VersionId: object.VersionId})
+		} else {
+			result.ObjectVersions = append(result.ObjectVersions, oss.ObjectVersionProperties{Key: object.Key, VersionId: object.VersionId})
+		}
+		result.NextKeyMarker, result.NextVersionIdMarker = object.Key, object.VersionId
+	}
+	return result, nil
+}
+
+func (b *testOssBucket) DeleteObjectVersions(objectVersions []oss.DeleteObject, options ...oss.Option) (oss.DeleteObjectVersionsResult, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if b.deleteErr != nil {
+		return oss.DeleteObjectVersionsResult{}, b.deleteErr
+	}
+	if len(objectVersions) > b.maxBatch {
+		b.maxBatch = len(objectVersions)
+	}
+	for _, object := range objectVersions {
+		b.deleted[object.Key+"/"+object.VersionId] = true
+	}
+	return oss.DeleteObjectVersionsResult{}, nil
+}
+
+func (b *testOssBucket) ListMultipartUploads(options ...oss.Option) (result oss.ListMultipartUploadResult, err error) {
+	params, _ := oss.GetRawParams(options)
+	keyMarker := fmt.Sprint(params["key-marker"])
+	maxUploads, _ := strconv.Atoi(fmt.Sprint(params["max-uploads"]))
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, upload := range b.uploads {
+		if keyMarker != "" && upload.Key <= keyMarker || b.deleted[upload.UploadID] {
+			continue
+		}
+		if len(result.Uploads) == maxUploads {
+			result.IsTruncated = true
+			break
+		}
+		result.Uploads = append(result.Uploads, upload)
+		result.NextKeyMarker, result.NextUploadIDMarker = upload.Key, upload.UploadID
+	}
+	return result, nil
+}
+
+func (b *testOssBucket) AbortMultipartUpload(imur oss.InitiateMultipartUploadResult, options ...oss.Option) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.deleted[imur.UploadID] = true
+	return nil
+}
+
+func (b *testOssBucket) ListLiveChannel(options ...oss.Option) (result oss.ListLiveChannelResult, err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, channel := range b.channels {
+		if !b.deleted[channel] {
+			result.LiveChannel = append(result.LiveChannel, oss.LiveChannelInfo{Name: channel})
+		}
+	}
+	return result, nil
+}
+
+func (b *testOssBucket) DeleteLiveChannel(channelName string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.deleted[channelName] = true
+	return nil
+}
+
+func (b *testOssBucket) remaining() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.versions) + len(b.uploads) + len(b.channels) - len(b.deleted)
+}
+
+func TestUnitAliCloudOssBucketEmptier(t *testing.T) {
+	bucket := newTestOssBucket(1234, 1500, 3)
+	emptier := newOssBucketEmptier("tf-test", bucket, time.Now().Add(time.Minute))
+	assert.Nil(t, emptier.empty())
+	assert.Equal(t, 0, bucket.remaining())
+	assert.Equal(t, OssDeleteObjectsBatchSize, bucket.maxBatch)
+	assert.Equal(t, 1234*3, emptier.deletedVersions)
+	assert.Equal(t, 1500, emptier.abortedUploads)
+	assert.Equal(t, 3, emptier.deletedChannels)
+
+	bucket = newTestOssBucket(2500, 0, 0)
+	bucket.deleteErr = oss.ServiceError{Code: "AccessDenied", StatusCode: 403}
+	err := newOssBucketEmptier("tf-test", bucket, time.Now().Add(time.Minute)).empty()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "DeleteObjectVersions")
+	assert.Contains(t, err.Error(), "AccessDenied")
+
+	bucket = newTestOssBucket(10, 0, 0)
+	err = newOssBucketEmptier("tf-test", bucket, time.Now().Add(-time.Second)).empty()
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "delete timeout")
+	assert.Equal(t, 30, bucket.remaining())
+}
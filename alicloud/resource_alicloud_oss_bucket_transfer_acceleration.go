@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketTransferAcceleration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketTransferAccelerationCreate,
-		Read:   resourceAliCloudOssBucketTransferAccelerationRead,
-		Update: resourceAliCloudOssBucketTransferAccelerationUpdate,
-		Delete: resourceAliCloudOssBucketTransferAccelerationDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketTransferAccelerationCreate,
+		Read:     resourceAliCloudOssBucketTransferAccelerationRead,
+		Update:   resourceAliCloudOssBucketTransferAccelerationUpdate,
+		Delete:   resourceAliCloudOssBucketTransferAccelerationDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketUserDefinedLogFields() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketUserDefinedLogFieldsCreate,
-		Read:   resourceAliCloudOssBucketUserDefinedLogFieldsRead,
-		Update: resourceAliCloudOssBucketUserDefinedLogFieldsUpdate,
-		Delete: resourceAliCloudOssBucketUserDefinedLogFieldsDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketUserDefinedLogFieldsCreate,
+		Read:     resourceAliCloudOssBucketUserDefinedLogFieldsRead,
+		Update:   resourceAliCloudOssBucketUserDefinedLogFieldsUpdate,
+		Delete:   resourceAliCloudOssBucketUserDefinedLogFieldsDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
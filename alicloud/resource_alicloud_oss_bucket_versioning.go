@@ -13,13 +13,11 @@ import (
 
 func resourceAliCloudOssBucketVersioning() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketVersioningCreate,
-		Read:   resourceAliCloudOssBucketVersioningRead,
-		Update: resourceAliCloudOssBucketVersioningUpdate,
-		Delete: resourceAliCloudOssBucketVersioningDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketVersioningCreate,
+		Read:     resourceAliCloudOssBucketVersioningRead,
+		Update:   resourceAliCloudOssBucketVersioningUpdate,
+		Delete:   resourceAliCloudOssBucketVersioningDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -14,13 +14,11 @@ import (
 
 func resourceAliCloudOssBucketWebsite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliCloudOssBucketWebsiteCreate,
-		Read:   resourceAliCloudOssBucketWebsiteRead,
-		Update: resourceAliCloudOssBucketWebsiteUpdate,
-		Delete: resourceAliCloudOssBucketWebsiteDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Create:   resourceAliCloudOssBucketWebsiteCreate,
+		Read:     resourceAliCloudOssBucketWebsiteRead,
+		Update:   resourceAliCloudOssBucketWebsiteUpdate,
+		Delete:   resourceAliCloudOssBucketWebsiteDelete,
+		Importer: ossBucketImporter(),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
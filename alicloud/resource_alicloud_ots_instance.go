@@ -306,8 +306,8 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if !d.IsNewResource() && hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
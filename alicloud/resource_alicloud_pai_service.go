@@ -275,7 +275,7 @@ func resourceAliCloudPaiServiceUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		paiServiceV2 := PaiServiceV2{client}
 		if err := paiServiceV2.SetResourceTags(d, "service"); err != nil {
 			return WrapError(err)
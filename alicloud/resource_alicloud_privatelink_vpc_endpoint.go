@@ -418,7 +418,7 @@ func resourceAliCloudPrivateLinkVpcEndpointUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		privateLinkServiceV2 := PrivateLinkServiceV2{client}
 		if err := privateLinkServiceV2.SetResourceTags(d, "VpcEndpoint"); err != nil {
 			return WrapError(err)
@@ -444,7 +444,7 @@ func resourceAliCloudPrivateLinkVpcEndpointServiceUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		privateLinkServiceV2 := PrivateLinkServiceV2{client}
 		if err := privateLinkServiceV2.SetResourceTags(d, "VpcEndpointService"); err != nil {
 			return WrapError(err)
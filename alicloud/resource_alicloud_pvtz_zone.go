@@ -350,7 +350,7 @@ func resourceAlicloudPvtzZoneUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("user_info")
 	}
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if err := pvtzService.SetResourceTags(d, "ZONE"); err != nil {
 			return WrapError(err)
@@ -18,9 +18,12 @@ func resourceAliCloudRamGroup() *schema.Resource {
 		Read:   resourceAliCloudRamGroupRead,
 		Update: resourceAliCloudRamGroupUpdate,
 		Delete: resourceAliCloudRamGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"group_name"},
+			},
+			importIdFromArn("ram", "group"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -17,9 +17,15 @@ func resourceAliCloudRamGroupPolicyAttachment() *schema.Resource {
 		Create: resourceAliCloudRamGroupPolicyAttachmentCreate,
 		Read:   resourceAliCloudRamGroupPolicyAttachmentRead,
 		Delete: resourceAliCloudRamGroupPolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"policy_name", "policy_type", "group_name"},
+				Patterns: map[string]string{
+					"policy_type": `^(System|Custom)$`,
+				},
+				Prefix: "group",
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -14,14 +14,17 @@ import (
 )
 
 func resourceAliCloudRamPolicy() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create: resourceAliCloudRamPolicyCreate,
 		Read:   resourceAliCloudRamPolicyRead,
 		Update: resourceAliCloudRamPolicyUpdate,
 		Delete: resourceAliCloudRamPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"policy_name"},
+			},
+			importIdFromArn("ram", "policy"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -140,7 +143,13 @@ func resourceAliCloudRamPolicy() *schema.Resource {
 				ConflictsWith: []string{"document"},
 			},
 		},
-	}
+	},
+		// the deprecated name and document are moved to the policy_name and policy_document since the schema version 1
+		stateUpgrade{
+			renameStateAttribute("name", "policy_name"),
+			renameStateAttribute("document", "policy_document"),
+		},
+	)
 }
 
 func resourceAliCloudRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
@@ -351,7 +360,7 @@ func resourceAliCloudRamPolicyUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ramServiceV2 := RamServiceV2{client}
 		if err := ramServiceV2.SetResourceTags(d, "policy"); err != nil {
 			return WrapError(err)
@@ -11,6 +11,7 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -664,3 +665,18 @@ variable "name" {
 }
 
 // Test Ram Policy. <<< Resource test cases, automatically generated.
+
+func TestUnitAliCloudRamPolicyStateUpgradeV0(t *testing.T) {
+	document := `{"Statement":[{"Action":["oss:ListObjects"],"Effect":"Allow","Resource":["acs:oss:*:*:mybucket"]}],"Version":"1"}`
+	upgrader := resourceAliCloudRamPolicy().StateUpgraders[0]
+	rawState, err := upgrader.Upgrade(map[string]interface{}{
+		"id":       "tf-test",
+		"name":     "tf-test",
+		"document": document,
+		"version":  "1",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-test", rawState["policy_name"])
+	assert.Equal(t, document, rawState["policy_document"])
+	assert.Equal(t, "tf-test", rawState["id"])
+}
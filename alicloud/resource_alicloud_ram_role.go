@@ -19,9 +19,12 @@ func resourceAliCloudRamRole() *schema.Resource {
 		Read:   resourceAliCloudRamRoleRead,
 		Update: resourceAliCloudRamRoleUpdate,
 		Delete: resourceAliCloudRamRoleDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"role_name"},
+			},
+			importIdFromArn("ram", "role"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -328,7 +331,7 @@ func resourceAliCloudRamRoleUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		ramServiceV2 := RamServiceV2{client}
 		if err := ramServiceV2.SetResourceTags(d, "role"); err != nil {
 			return WrapError(err)
@@ -17,9 +17,15 @@ func resourceAliCloudRamRolePolicyAttachment() *schema.Resource {
 		Create: resourceAliCloudRamRolePolicyAttachmentCreate,
 		Read:   resourceAliCloudRamRolePolicyAttachmentRead,
 		Delete: resourceAliCloudRamRolePolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"policy_name", "policy_type", "role_name"},
+				Patterns: map[string]string{
+					"policy_type": `^(System|Custom)$`,
+				},
+				Prefix: "role",
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
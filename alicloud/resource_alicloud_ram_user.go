@@ -1,8 +1,10 @@
 package alicloud
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
@@ -17,9 +19,15 @@ func resourceAlicloudRamUser() *schema.Resource {
 		Read:   resourceAlicloudRamUserRead,
 		Update: resourceAlicloudRamUserUpdate,
 		Delete: resourceAlicloudRamUserDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"user_id"},
+				Patterns: map[string]string{
+					"user_id": `^[0-9]+$`,
+				},
+			},
+			ramUserImportIdByName(),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(3 * time.Minute),
 			Update: schema.DefaultTimeout(3 * time.Minute),
@@ -472,3 +480,32 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 	addDebug(deleteUserRequest.GetActionName(), raw, deleteUserRequest.RpcRequest, deleteUserRequest)
 	return WrapError(ramService.WaitForRamUser(d.Id(), Deleted, DefaultTimeout))
 }
+
+// ramUserImportIdByName resolves the user name to the user id, so a RAM user can also be imported by its name.
+func ramUserImportIdByName() ImportIdAlternative {
+	return ImportIdAlternative{
+		Description: "the name of the user",
+		Resolve: func(client *connectivity.AliyunClient, name string) (string, error) {
+			if client == nil {
+				return "", nil
+			}
+			action := "GetUser"
+			request := map[string]interface{}{
+				"UserName": name,
+			}
+			response, err := client.RpcPost("Ram", "2015-05-01", action, nil, request, false)
+			addDebug(action, response, request)
+			if err != nil {
+				if IsExpectedErrors(err, []string{"EntityNotExist.User"}) || NotFoundError(err) {
+					return "", nil
+				}
+				return "", WrapErrorf(err, DefaultErrorMsg, name, action, AlibabaCloudSdkGoERROR)
+			}
+			userId, err := jsonpath.Get("$.User.UserId", response)
+			if err != nil {
+				return "", nil
+			}
+			return fmt.Sprint(userId), nil
+		},
+	}
+}
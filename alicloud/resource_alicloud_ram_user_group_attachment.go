@@ -16,9 +16,11 @@ func resourceAliCloudRamUserGroupAttachment() *schema.Resource {
 		Create: resourceAliCloudRamUserGroupAttachmentCreate,
 		Read:   resourceAliCloudRamUserGroupAttachmentRead,
 		Delete: resourceAliCloudRamUserGroupAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"group_name", "user_name"},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
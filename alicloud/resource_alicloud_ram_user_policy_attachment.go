@@ -16,9 +16,15 @@ func resourceAliCloudRamUserPolicyAttachment() *schema.Resource {
 		Create: resourceAliCloudRamUserPolicyAttachmentCreate,
 		Read:   resourceAliCloudRamUserPolicyAttachmentRead,
 		Delete: resourceAliCloudRamUserPolicyAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"policy_name", "policy_type", "user_name"},
+				Patterns: map[string]string{
+					"policy_type": `^(System|Custom)$`,
+				},
+				Prefix: "user",
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -438,7 +438,7 @@ func resourceAliCloudRdsCustomDiskUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := rdsServiceV2.SetResourceTags(d, "CustomDisk"); err != nil {
 			return WrapError(err)
 		}
@@ -398,7 +398,7 @@ func resourceAliCloudRealtimeComputeVvpInstanceUpdate(d *schema.ResourceData, me
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		realtimeComputeServiceV2 := RealtimeComputeServiceV2{client}
 		if err := realtimeComputeServiceV2.SetResourceTags(d, "vvpinstance"); err != nil {
 			return WrapError(err)
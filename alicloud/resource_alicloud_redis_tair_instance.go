@@ -1185,7 +1185,7 @@ func resourceAliCloudRedisTairInstanceUpdate(d *schema.ResourceData, meta interf
 
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		redisServiceV2 := RedisServiceV2{client}
 		if err := redisServiceV2.SetResourceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
@@ -254,7 +254,7 @@ func resourceAliCloudReservedInstanceUpdate(d *schema.ResourceData, meta interfa
 	var err error
 	ecsService := EcsService{client}
 	d.Partial(true)
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := ecsService.SetResourceTags(d, "reservedinstance"); err != nil {
 			return WrapError(err)
 		}
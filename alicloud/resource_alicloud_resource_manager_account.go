@@ -285,7 +285,7 @@ func resourceAliCloudResourceManagerAccountUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceManagerServiceV2 := ResourceManagerServiceV2{client}
 		if err := resourceManagerServiceV2.SetResourceTags(d, "Account"); err != nil {
 			return WrapError(err)
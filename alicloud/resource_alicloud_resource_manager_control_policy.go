@@ -176,7 +176,7 @@ func resourceAliCloudResourceManagerControlPolicyUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceManagerServiceV2 := ResourceManagerServiceV2{client}
 		if err := resourceManagerServiceV2.SetResourceTags(d, "ControlPolicy"); err != nil {
 			return WrapError(err)
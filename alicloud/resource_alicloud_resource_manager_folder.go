@@ -149,7 +149,7 @@ func resourceAliCloudResourceManagerFolderUpdate(d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceManagerServiceV2 := ResourceManagerServiceV2{client}
 		if err := resourceManagerServiceV2.SetResourceTags(d, "Folder"); err != nil {
 			return WrapError(err)
@@ -225,7 +225,7 @@ func resourceAliCloudResourceManagerResourceGroupUpdate(d *schema.ResourceData,
 		d.SetPartial("display_name")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := resourceManagerService.SetResourceTags(d, "ResourceGroup"); err != nil {
 			return WrapError(err)
 		}
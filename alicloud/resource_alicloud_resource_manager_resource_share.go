@@ -306,7 +306,7 @@ func resourceAliCloudResourceManagerResourceShareUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		resourceManagerServiceV2 := ResourceManagerServiceV2{client}
 		if err := resourceManagerServiceV2.SetResourceTagsForResourceSharing(d, "ResourceShare"); err != nil {
 			return WrapError(err)
@@ -986,7 +986,7 @@ func resourceAliCloudRocketmqInstanceUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		rocketmqServiceV2 := RocketmqServiceV2{client}
 		if err := rocketmqServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
@@ -302,7 +302,7 @@ func resourceAlicloudRosStackUpdate(d *schema.ResourceData, meta interface{}) er
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := rosService.SetResourceTags(d, "stack"); err != nil {
 			return WrapError(err)
 		}
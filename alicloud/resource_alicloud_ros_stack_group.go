@@ -476,7 +476,7 @@ func resourceAliCloudRosStackGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		rosServiceV2 := RosServiceV2{client}
 		if err := rosServiceV2.SetResourceTags(d, "stackgroup"); err != nil {
 			return WrapError(err)
@@ -120,7 +120,7 @@ func resourceAlicloudRosTemplateUpdate(d *schema.ResourceData, meta interface{})
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := rosService.SetResourceTags(d, "template"); err != nil {
 			return WrapError(err)
 		}
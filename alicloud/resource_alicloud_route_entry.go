@@ -15,9 +15,11 @@ func resourceAliyunRouteEntry() *schema.Resource {
 		Create: resourceAliyunRouteEntryCreate,
 		Read:   resourceAliyunRouteEntryRead,
 		Delete: resourceAliyunRouteEntryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"route_table_id", "router_id", "destination_cidrblock", "nexthop_type", "nexthop_id"},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
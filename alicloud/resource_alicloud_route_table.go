@@ -226,7 +226,7 @@ func resourceAliCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "ROUTETABLE"); err != nil {
 			return WrapError(err)
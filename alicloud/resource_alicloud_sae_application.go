@@ -2197,7 +2197,7 @@ func resourceAliCloudSaeApplicationUpdate(d *schema.ResourceData, meta interface
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := saeService.SetResourceTags(d, "application"); err != nil {
 			return WrapError(err)
 		}
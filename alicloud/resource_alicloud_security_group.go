@@ -13,14 +13,21 @@ import (
 )
 
 func resourceAliCloudEcsSecurityGroup() *schema.Resource {
-	return &schema.Resource{
+	return withStateUpgrades(&schema.Resource{
 		Create: resourceAliCloudEcsSecurityGroupCreate,
 		Read:   resourceAliCloudEcsSecurityGroupRead,
 		Update: resourceAliCloudEcsSecurityGroupUpdate,
 		Delete: resourceAliCloudEcsSecurityGroupDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"security_group_id"},
+				Patterns: map[string]string{
+					"security_group_id": `^sg-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("ecs", "securitygroup"),
+			importIdByName("Ecs", "2014-05-26", "DescribeSecurityGroups", "SecurityGroupName", "$.SecurityGroups.SecurityGroup[*]", "SecurityGroupName", "SecurityGroupId", map[string]interface{}{"PageSize": PageSizeLarge}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -85,7 +92,16 @@ func resourceAliCloudEcsSecurityGroup() *schema.Resource {
 				Deprecated: "Field `inner_access` has been deprecated from provider version 1.55.3. New field `inner_access_policy` instead.",
 			},
 		},
-	}
+	},
+		// the deprecated name and inner_access are moved to the security_group_name and inner_access_policy since the schema version 1
+		stateUpgrade{
+			renameStateAttribute("name", "security_group_name"),
+			mapStateAttributeValue("inner_access", "inner_access_policy", map[string]interface{}{
+				"true":  string(GroupInnerAccept),
+				"false": string(GroupInnerDrop),
+			}),
+		},
+	)
 }
 
 func resourceAliCloudEcsSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
@@ -333,7 +349,7 @@ func resourceAliCloudEcsSecurityGroupUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		if err := ecsServiceV2.SetResourceTags(d, "securitygroup"); err != nil {
 			return WrapError(err)
 		}
@@ -11,6 +11,7 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -554,3 +555,27 @@ func AliCloudEcsSecurityGroupBasicDependence8588(name string) string {
 }
 
 // Test Ecs SecurityGroup. <<< Resource test cases, automatically generated.
+
+func TestUnitAliCloudEcsSecurityGroupStateUpgradeV0(t *testing.T) {
+	upgrader := resourceAliCloudEcsSecurityGroup().StateUpgraders[0]
+	rawState, err := upgrader.Upgrade(map[string]interface{}{
+		"id":           "sg-abc123456",
+		"name":         "tf-test",
+		"inner_access": true,
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "sg-abc123456", rawState["id"])
+	assert.Equal(t, "tf-test", rawState["security_group_name"])
+	assert.Equal(t, "Accept", rawState["inner_access_policy"])
+
+	rawState, err = upgrader.Upgrade(map[string]interface{}{
+		"id":                  "sg-abc123456",
+		"name":                "tf-old",
+		"security_group_name": "tf-new",
+		"inner_access":        false,
+		"inner_access_policy": "",
+	}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "tf-new", rawState["security_group_name"])
+	assert.Equal(t, "Drop", rawState["inner_access_policy"])
+}
@@ -478,7 +478,7 @@ func resourceAliCloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		d.SetPartial("db_instance_description")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		if err := selectDBService.SetResourceTags(d.Id(), added, removed); err != nil {
 			return WrapError(err)
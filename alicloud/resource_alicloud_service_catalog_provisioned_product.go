@@ -312,7 +312,7 @@ func resourceAlicloudServiceCatalogProvisionedProductUpdate(d *schema.ResourceDa
 		update = true
 	}
 	request["ProductVersionId"] = d.Get("product_version_id")
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			request["Tags"] = tagsFromMap(v.(map[string]interface{}))
@@ -1839,7 +1839,7 @@ func resourceAliCloudServiceMeshServiceMeshUpdate(d *schema.ResourceData, meta i
 			}
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		serviceMeshServiceV2 := ServiceMeshServiceV2{client}
 		if err := serviceMeshServiceV2.SetResourceTags(d, "servicemesh"); err != nil {
 			return WrapError(err)
@@ -152,7 +152,7 @@ func resourceAlicloudSlbAclUpdate(d *schema.ResourceData, meta interface{}) erro
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := slbService.setInstanceTags(d, TagResourceAcl); err != nil {
 			return WrapError(err)
 		}
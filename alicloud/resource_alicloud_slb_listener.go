@@ -21,9 +21,21 @@ func resourceAliCloudSlbListener() *schema.Resource {
 		Read:   resourceAliCloudSlbListenerRead,
 		Update: resourceAliCloudSlbListenerUpdate,
 		Delete: resourceAliCloudSlbListenerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"load_balancer_id", "protocol", "frontend_port"},
+				Patterns: map[string]string{
+					"protocol":      `^(http|https|tcp|udp)$`,
+					"frontend_port": `^[0-9]+$`,
+				},
+			},
+			importIdFromFormat(ImportIdFormat{
+				Parts: []string{"load_balancer_id", "frontend_port"},
+				Patterns: map[string]string{
+					"frontend_port": `^[0-9]+$`,
+				},
+			}),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
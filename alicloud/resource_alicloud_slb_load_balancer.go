@@ -17,9 +17,16 @@ func resourceAlicloudSlbLoadBalancer() *schema.Resource {
 		Read:   resourceAlicloudSlbLoadBalancerRead,
 		Update: resourceAlicloudSlbLoadBalancerUpdate,
 		Delete: resourceAlicloudSlbLoadBalancerDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"load_balancer_id"},
+				Patterns: map[string]string{
+					"load_balancer_id": `^lb-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("slb", "loadbalancer"),
+			importIdByName("Slb", "2014-05-15", "DescribeLoadBalancers", "LoadBalancerName", "$.LoadBalancers.LoadBalancer[*]", "LoadBalancerName", "LoadBalancerId", map[string]interface{}{"PageSize": PageSizeXLarge}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(9 * time.Minute),
@@ -385,7 +392,7 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 	var err error
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := slbService.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
 		}
@@ -15,9 +15,14 @@ func resourceAliCloudSlbServerGroupServerAttachment() *schema.Resource {
 		Create: resourceAliCloudSlbServerGroupServerAttachmentCreate,
 		Read:   resourceAliCloudSlbServerGroupServerAttachmentRead,
 		Delete: resourceAliCloudSlbServerGroupServerAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"server_group_id", "server_id", "port"},
+				Patterns: map[string]string{
+					"port": `^[0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
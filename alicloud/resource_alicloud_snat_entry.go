@@ -19,9 +19,14 @@ func resourceAliCloudNATGatewaySnatEntry() *schema.Resource {
 		Read:   resourceAliCloudNATGatewaySnatEntryRead,
 		Update: resourceAliCloudNATGatewaySnatEntryUpdate,
 		Delete: resourceAliCloudNATGatewaySnatEntryDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"snat_table_id", "snat_entry_id"},
+				Patterns: map[string]string{
+					"snat_entry_id": `^snat-[a-z0-9]+$`,
+				},
+			},
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -194,7 +194,7 @@ func resourceAliCloudSslCertificatesServiceCertificateUpdate(d *schema.ResourceD
 	query = make(map[string]interface{})
 	request["CertificateId"] = d.Id()
 	request["RegionId"] = client.RegionId
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		update = true
 		if v, ok := d.GetOk("tags"); ok {
 			tagsMap := ConvertTags(v.(map[string]interface{}))
@@ -411,7 +411,7 @@ func resourceAliCloudSslCertificatesServicePcaCertUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		sslCertificatesServiceServiceV2 := SslCertificatesServiceServiceV2{client}
 		if err := sslCertificatesServiceServiceV2.SetResourceTags(d, "PcaCertificate"); err != nil {
 			return WrapError(err)
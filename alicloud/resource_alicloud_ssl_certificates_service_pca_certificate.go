@@ -340,7 +340,7 @@ func resourceAliCloudSslCertificatesServicePcaCertificateUpdate(d *schema.Resour
 		}
 	}
 
-	if !d.IsNewResource() && d.HasChange("tags") {
+	if !d.IsNewResource() && hasTagsChange(d) {
 		sslCertificatesServiceServiceV2 := SslCertificatesServiceServiceV2{client}
 		if err := sslCertificatesServiceServiceV2.SetResourceTags(d, "PcaCertificate"); err != nil {
 			return WrapError(err)
@@ -557,7 +557,7 @@ func resourceAliCloudStarRocksInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		starRocksServiceV2 := StarRocksServiceV2{client}
 		if err := starRocksServiceV2.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
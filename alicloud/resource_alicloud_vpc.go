@@ -17,9 +17,16 @@ func resourceAliCloudVpcVpc() *schema.Resource {
 		Read:   resourceAliCloudVpcVpcRead,
 		Update: resourceAliCloudVpcVpcUpdate,
 		Delete: resourceAliCloudVpcVpcDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"vpc_id"},
+				Patterns: map[string]string{
+					"vpc_id": `^vpc-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("vpc", "vpc"),
+			importIdByName("Vpc", "2016-04-28", "DescribeVpcs", "VpcName", "$.Vpcs.Vpc[*]", "VpcName", "VpcId", map[string]interface{}{"PageSize": PageSizeLarge}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -754,7 +761,7 @@ func resourceAliCloudVpcVpcUpdate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "VPC"); err != nil {
 			return WrapError(err)
@@ -423,7 +423,7 @@ func resourceAliCloudVpcDhcpOptionsSetUpdate(d *schema.ResourceData, meta interf
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "DhcpOptionsSet"); err != nil {
 			return WrapError(err)
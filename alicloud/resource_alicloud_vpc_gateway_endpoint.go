@@ -335,7 +335,7 @@ func resourceAliCloudVpcGatewayEndpointUpdate(d *schema.ResourceData, meta inter
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "GatewayEndpoint"); err != nil {
 			return WrapError(err)
@@ -295,7 +295,7 @@ func resourceAlicloudVpcHaVipUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "HAVIP"); err != nil {
@@ -302,7 +302,7 @@ func resourceAliCloudVpcIpamIpamUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcIpamServiceV2 := VpcIpamServiceV2{client}
 		if err := vpcIpamServiceV2.SetResourceTags(d, "IPAM"); err != nil {
 			return WrapError(err)
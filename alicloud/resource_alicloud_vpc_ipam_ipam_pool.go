@@ -312,7 +312,7 @@ func resourceAliCloudVpcIpamIpamPoolUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcIpamServiceV2 := VpcIpamServiceV2{client}
 		if err := vpcIpamServiceV2.SetResourceTags(d, "IPAMPOOL"); err != nil {
 			return WrapError(err)
@@ -238,7 +238,7 @@ func resourceAliCloudVpcIpamIpamScopeUpdate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcIpamServiceV2 := VpcIpamServiceV2{client}
 		if err := vpcIpamServiceV2.SetResourceTags(d, "IPAMSCOPE"); err != nil {
 			return WrapError(err)
@@ -287,7 +287,7 @@ func resourceAliCloudVpcIpv4GatewayUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "IPV4GATEWAY"); err != nil {
 			return WrapError(err)
@@ -235,7 +235,7 @@ func resourceAliCloudVpcIpv6AddressUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "ipv6address"); err != nil {
 			return WrapError(err)
@@ -248,7 +248,7 @@ func resourceAlicloudVpcIpv6GatewayUpdate(d *schema.ResourceData, meta interface
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "IPV6GATEWAY"); err != nil {
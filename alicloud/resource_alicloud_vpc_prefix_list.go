@@ -465,7 +465,7 @@ func resourceAlicloudVpcPrefixListUpdate(d *schema.ResourceData, meta interface{
 
 	}
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "PrefixList"); err != nil {
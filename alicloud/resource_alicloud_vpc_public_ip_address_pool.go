@@ -298,7 +298,7 @@ func resourceAliCloudVpcPublicIpAddressPoolUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "PUBLICIPADDRESSPOOL"); err != nil {
 			return WrapError(err)
@@ -449,7 +449,7 @@ func TestAccAliCloudVPC_basic1(t *testing.T) {
 	}, "DescribeVpc")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
-	rand := acctest.RandIntRange(10000, 99999)
+	rand := testAccRecorder(t).Intn(90000) + 10000
 	name := fmt.Sprintf("tf-testAcc%sVpc%d", defaultRegionToTest, rand)
 	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudVpcBasicDependence1)
 	resource.Test(t, resource.TestCase{
@@ -1217,7 +1217,7 @@ func resourceAliCloudVPNGatewayVpnConnectionUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vPNGatewayServiceV2 := VPNGatewayServiceV2{client}
 		if err := vPNGatewayServiceV2.SetResourceTags(d, "VPNCONNECTION"); err != nil {
 			return WrapError(err)
@@ -185,7 +185,7 @@ func resourceAliCloudVPNGatewayCustomerGatewayUpdate(d *schema.ResourceData, met
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vPNGatewayServiceV2 := VPNGatewayServiceV2{client}
 		if err := vPNGatewayServiceV2.SetResourceTags(d, "CUSTOMERGATEWAY"); err != nil {
 			return WrapError(err)
@@ -397,7 +397,7 @@ func resourceAliCloudVPNGatewayVPNGatewayUpdate(d *schema.ResourceData, meta int
 		d.SetPartial("resource_group_id")
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vPNGatewayServiceV2 := VPNGatewayServiceV2{client}
 		if err := vPNGatewayServiceV2.SetResourceTags(d, "VpnGateWay"); err != nil {
 			return WrapError(err)
@@ -231,7 +231,7 @@ func resourceAliCloudVpnGatewayEnhancedVpnGatewayUpdate(d *schema.ResourceData,
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vPNGatewayServiceV2 := VPNGatewayServiceV2{client}
 		if err := vPNGatewayServiceV2.SetResourceTags(d, "VpnGateWay"); err != nil {
 			return WrapError(err)
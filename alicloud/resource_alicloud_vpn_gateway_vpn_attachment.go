@@ -1245,7 +1245,7 @@ func resourceAliCloudVpnGatewayVpnAttachmentUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		vPNGatewayServiceV2 := VPNGatewayServiceV2{client}
 		if err := vPNGatewayServiceV2.SetResourceTags(d, "VPNATTACHMENT"); err != nil {
 			return WrapError(err)
@@ -20,9 +20,16 @@ func resourceAliCloudVpcVswitch() *schema.Resource {
 		Read:   resourceAliCloudVpcVswitchRead,
 		Update: resourceAliCloudVpcVswitchUpdate,
 		Delete: resourceAliCloudVpcVswitchDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: ImportStateWithIdFormat(
+			ImportIdFormat{
+				Parts: []string{"vswitch_id"},
+				Patterns: map[string]string{
+					"vswitch_id": `^vsw-[a-z0-9]+$`,
+				},
+			},
+			importIdFromArn("vpc", "vswitch"),
+			importIdByName("Vpc", "2016-04-28", "DescribeVSwitches", "VSwitchName", "$.VSwitches.VSwitch[*]", "VSwitchName", "VSwitchId", map[string]interface{}{"PageSize": PageSizeLarge}),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -354,7 +361,7 @@ func resourceAliCloudVpcVswitchUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	update = false
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		update = true
 		vpcServiceV2 := VpcServiceV2{client}
 		if err := vpcServiceV2.SetResourceTags(d, "VSWITCH"); err != nil {
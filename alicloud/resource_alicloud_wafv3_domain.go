@@ -860,7 +860,7 @@ func resourceAliCloudWafv3DomainUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		wafv3ServiceV2 := Wafv3ServiceV2{client}
 		if err := wafv3ServiceV2.SetResourceTags(d, "ALIYUN::WAF::DEFENSERESOURCE"); err != nil {
 			return WrapError(err)
@@ -168,7 +168,7 @@ func resourceAlicloudDbauditInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		if err := dbauditService.setInstanceTags(d, "INSTANCE"); err != nil {
 			return WrapError(err)
 		}
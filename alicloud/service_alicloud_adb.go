@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -261,8 +259,8 @@ func (s *AdbService) WaitForAdbInstance(id string, status Status, timeout int) e
 }
 
 func (s *AdbService) setClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -350,16 +348,7 @@ func (s *AdbService) tagsFromMap(m map[string]interface{}) []adb.TagResourcesTag
 }
 
 func (s *AdbService) ignoreTag(t adb.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagValue)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *AdbService) DescribeTags(resourceId string, resourceType TagResourceType) (tags []adb.TagResource, err error) {
@@ -653,7 +642,7 @@ func (s *AdbService) DescribeDBClusterAccessWhiteList(id string) (object map[str
 func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 	var response map[string]interface{}
 	var err error
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
 		for _, v := range removed {
@@ -260,7 +260,7 @@ func (s *AlbServiceV2) DescribeAsyncAlbLoadBalancerStateRefreshFunc(d *schema.Re
 
 // SetResourceTags <<< Encapsulated tag function for Alb.
 func (s *AlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -90,6 +90,116 @@ func (s *AlidnsService) DescribeAlidnsRecord(id string) (object alidns.DescribeD
 	return *response, nil
 }
 
+// DescribeAlidnsRecordSet returns all of the records of the rr, type and line. The id is formatted as
+// <domain_name>:<rr>:<type>:<line>.
+func (s *AlidnsService) DescribeAlidnsRecordSet(id string) (objects []alidns.Record, err error) {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := alidns.CreateDescribeSubDomainRecordsRequest()
+	request.RegionId = s.client.RegionId
+	request.DomainName = parts[0]
+	request.SubDomain = parts[1] + "." + parts[0]
+	if parts[1] == "@" {
+		request.SubDomain = parts[0]
+	}
+	request.Type = parts[2]
+	request.Line = parts[3]
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeXLarge)
+	for {
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		var raw interface{}
+		err = resource.Retry(s.client.GetRetryTimeout(5*time.Minute), func() *resource.RetryError {
+			raw, err = s.client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+				return alidnsClient.DescribeSubDomainRecords(request)
+			})
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidDomainName.NoExist", "DomainRecordNotBelongToUser"}) {
+				return nil, WrapErrorf(NotFoundErr("AlidnsRecordSet", id), NotFoundMsg, ProviderERROR)
+			}
+			return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*alidns.DescribeSubDomainRecordsResponse)
+		for _, object := range response.DomainRecords.Record {
+			if object.RR == parts[1] && object.Type == parts[2] && object.Line == parts[3] {
+				objects = append(objects, object)
+			}
+		}
+		if len(response.DomainRecords.Record) < PageSizeXLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return nil, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	if len(objects) < 1 {
+		return nil, WrapErrorf(NotFoundErr("AlidnsRecordSet", id), NotFoundMsg, ProviderERROR)
+	}
+	return objects, nil
+}
+
+// DescribeAlidnsRecordSetWeightEnabled returns whether the weighted round-robin is enabled for the rr, type and line.
+func (s *AlidnsService) DescribeAlidnsRecordSetWeightEnabled(id string) (bool, error) {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return false, WrapError(err)
+	}
+	subDomain := parts[1] + "." + parts[0]
+	request := alidns.CreateDescribeDNSSLBSubDomainsRequest()
+	request.RegionId = s.client.RegionId
+	request.DomainName = parts[0]
+	request.Rr = parts[1]
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeXLarge)
+	for {
+		raw, err := s.client.WithAlidnsClient(func(alidnsClient *alidns.Client) (interface{}, error) {
+			return alidnsClient.DescribeDNSSLBSubDomains(request)
+		})
+		if err != nil {
+			return false, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*alidns.DescribeDNSSLBSubDomainsResponse)
+		for _, object := range response.SlbSubDomains.SlbSubDomain {
+			// the sub domain of @ may be returned with or without the @
+			if (object.SubDomain != subDomain && !(parts[1] == "@" && object.SubDomain == parts[0])) || object.Type != parts[2] {
+				continue
+			}
+			for _, line := range object.LineAlgorithms.LineAlgorithm {
+				if line.Line == parts[3] {
+					return line.Open, nil
+				}
+			}
+			if len(object.LineAlgorithms.LineAlgorithm) == 0 {
+				return object.Open, nil
+			}
+		}
+		if len(response.SlbSubDomains.SlbSubDomain) < PageSizeXLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return false, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return false, nil
+}
+
 func (s *AlidnsService) ListTagResources(id string) (object alidns.ListTagResourcesResponse, err error) {
 	request := alidns.CreateListTagResourcesRequest()
 	request.RegionId = s.client.RegionId
@@ -110,7 +220,7 @@ func (s *AlidnsService) ListTagResources(id string) (object alidns.ListTagResour
 }
 
 func (s *AlidnsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsChange(d)
 	added := make([]alidns.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, alidns.TagResourcesTag{
@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -575,8 +573,8 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -658,16 +656,7 @@ func (s *AlikafkaService) tagsToMap(tags []alikafka.TagResource) map[string]stri
 }
 
 func (s *AlikafkaService) ignoreTag(t alikafka.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *AlikafkaService) tagVOTagsToMap(tags []alikafka.TagVO) map[string]string {
@@ -681,16 +670,7 @@ func (s *AlikafkaService) tagVOTagsToMap(tags []alikafka.TagVO) map[string]strin
 }
 
 func (s *AlikafkaService) tagVOIgnoreTag(t alikafka.TagVO) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *AlikafkaService) diffTags(oldTags, newTags []alikafka.TagResourcesTag) ([]alikafka.TagResourcesTag, []alikafka.TagResourcesTag) {
@@ -755,7 +735,7 @@ func (s *AlikafkaService) GetAllowedIpList(id string) (object map[string]interfa
 }
 
 func (s *AlikafkaService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
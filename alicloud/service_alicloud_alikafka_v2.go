@@ -106,7 +106,7 @@ func (s *AlikafkaServiceV2) SetResourceTags(d *schema.ResourceData, resourceType
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
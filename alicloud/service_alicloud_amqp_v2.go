@@ -563,7 +563,7 @@ func (s *AmqpServiceV2) DescribeInstanceListTagResources(id string) (object map[
 // SetResourceTags <<< Encapsulated tag function for Amqp.
 
 func (s *AmqpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -3,8 +3,6 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"regexp"
 	"strconv"
 	"time"
 
@@ -467,7 +465,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -522,16 +520,7 @@ func (s *CloudApiService) tagsToMap(tags []cloudapi.TagResource) map[string]stri
 }
 
 func (s *CloudApiService) ignoreTag(t cloudapi.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *CloudApiService) diffTags(oldTags, newTags []cloudapi.TagResourcesTag) ([]cloudapi.TagResourcesTag, []cloudapi.TagResourcesTag) {
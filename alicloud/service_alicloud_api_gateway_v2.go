@@ -151,7 +151,7 @@ func (s *ApiGatewayServiceV2) ApiGatewayPluginStateRefreshFunc(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for ApiGateway.
 func (s *ApiGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -239,7 +239,7 @@ func (s *ApigServiceV2) ApigGatewayStateRefreshFunc(id string, field string, fai
 
 // SetResourceTags <<< Encapsulated tag function for Apig.
 func (s *ApigServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -382,7 +382,7 @@ func (s *ArmsService) SetResourceTags(d *schema.ResourceData, resourceType strin
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -220,7 +220,7 @@ func (s *ArmsServiceV2) ArmsEnvironmentStateRefreshFunc(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for Arms.
 func (s *ArmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -181,10 +179,10 @@ func (s *CassandraService) DescribeCassandraEndpoints(id string) (object cassand
 }
 
 func (s *CassandraService) setInstanceTags(d *schema.ResourceData) error {
-	if !d.HasChange("tags") {
+	if !hasTagsChange(d) {
 		return nil
 	}
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -269,16 +267,7 @@ func (s *CassandraService) tagsToMap(tags []cassandra.Tag) map[string]string {
 }
 
 func (s *CassandraService) ignoreTag(t cassandra.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *CassandraService) DescribeAccounts(id string) (object cassandra.DescribeAccountsResponse, err error) {
@@ -166,7 +166,7 @@ func (s *CbnService) CenInstanceStateRefreshFunc(d *schema.ResourceData, failSta
 }
 
 func (s *CbnService) setResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsChange(d)
 	added := make([]cbn.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, cbn.TagResourcesTag{
@@ -562,7 +562,7 @@ func (s *CbnService) SetResourceTags(d *schema.ResourceData, resourceType string
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
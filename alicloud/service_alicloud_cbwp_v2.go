@@ -123,7 +123,7 @@ func (s *CbwpServiceV2) CbwpCommonBandwidthPackageStateRefreshFunc(id string, fi
 
 // SetResourceTags <<< Encapsulated tag function for Cbwp.
 func (s *CbwpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
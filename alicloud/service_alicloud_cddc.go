@@ -109,7 +109,7 @@ func (s *CddcService) SetResourceTags(d *schema.ResourceData, resourceType strin
 	if err != nil {
 		return WrapError(err)
 	}
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		client := s.client
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
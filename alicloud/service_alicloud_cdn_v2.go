@@ -166,7 +166,7 @@ func (s *CdnServiceV2) CdnDomainStateRefreshFunc(id string, field string, failSt
 
 // SetResourceTags <<< Encapsulated tag function for Cdn.
 func (s *CdnServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
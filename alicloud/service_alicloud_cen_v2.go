@@ -98,7 +98,7 @@ func (s *CenServiceV2) CenTransitRouterPeerAttachmentStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for Cen.
 func (s *CenServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
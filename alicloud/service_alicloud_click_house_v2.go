@@ -699,7 +699,7 @@ func (s *ClickHouseServiceV2) ClickHouseEnterpriseDbClusterStateRefreshFuncWithA
 
 // SetResourceTags <<< Encapsulated tag function for ClickHouse.
 func (s *ClickHouseServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -1081,7 +1081,7 @@ func (s *CloudSSOServiceV2) CloudSsoUserStateRefreshFuncWithApi(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for CloudSso.
 func (s *CloudSSOServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -355,7 +355,7 @@ func (s *CmsService) DescribeCmsGroupMetricRule(id string) (object map[string]in
 
 func (s *CmsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 	client := s.client
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
 		for _, v := range removed {
@@ -369,7 +369,7 @@ func (s *CmsService) SetResourceTags(d *schema.ResourceData, resourceType string
 				"RegionId":   s.client.RegionId,
 				"GroupIds.1": d.Id(),
 			}
-			oraw, _ := d.GetChange("tags")
+			oraw, _ := getTagsChange(d)
 			removedTags := oraw.(map[string]interface{})
 			count := 1
 			for _, key := range removedTagKeys {
@@ -581,3 +581,50 @@ func (s *ConfigServiceV2) ConfigAggregateRemediationStateRefreshFuncWithApi(id s
 }
 
 // DescribeConfigAggregateRemediation >>> Encapsulated.
+
+// ListConfigDiscoveredResources lists the resources of the resource types, like ACS::ECS::Instance, which are
+// discovered by Cloud Config. The deleted resources are not returned.
+func (s *ConfigServiceV2) ListConfigDiscoveredResources(resourceTypes []string) (objects []map[string]interface{}, err error) {
+	client := s.client
+	var response map[string]interface{}
+	action := "ListDiscoveredResources"
+	request := make(map[string]interface{})
+	request["ResourceTypes"] = strings.Join(resourceTypes, ",")
+	request["ResourceDeleted"] = 1
+	request["MaxResults"] = PageSizeXLarge
+	for {
+		wait := incrementalWait(3*time.Second, 5*time.Second)
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			response, err = client.RpcPost("Config", "2020-09-07", action, nil, request, true)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, strings.Join(resourceTypes, ","), action, AlibabaCloudSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.DiscoveredResourceProfiles.DiscoveredResourceProfileList", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, action, "$.DiscoveredResourceProfiles.DiscoveredResourceProfileList", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			if object, ok := item.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		nextToken, _ := jsonpath.Get("$.DiscoveredResourceProfiles.NextToken", response)
+		if token, ok := nextToken.(string); ok && token != "" {
+			request["NextToken"] = token
+		} else {
+			break
+		}
+	}
+	return objects, nil
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -1001,16 +1000,7 @@ func (s *CsService) tagsToMap(tags []cs.Tag) map[string]string {
 }
 
 func (s *CsService) ignoreTag(t cs.Tag) bool {
-	filter := []string{"^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, "http://", "https://")
 }
 
 func (s *CsService) GetPermanentToken(clusterId string) (string, error) {
@@ -85,7 +85,7 @@ func (s *DataWorksServiceV2) DataWorksProjectStateRefreshFunc(id string, field s
 
 // SetResourceTags <<< Encapsulated tag function for DataWorks.
 func (s *DataWorksServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -241,7 +241,7 @@ func (s *DdosBgpServiceV2) DdosBgpInstanceStateRefreshFunc(id string, field stri
 
 // SetResourceTags <<< Encapsulated tag function for DdosBgp.
 func (s *DdosBgpServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -589,7 +589,7 @@ func (s *DdosCooServiceV2) DdosCooInstanceStateRefreshFunc(id string, field stri
 
 // SetResourceTags <<< Encapsulated tag function for DdosCoo.
 func (s *DdosCooServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
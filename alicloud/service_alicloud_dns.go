@@ -197,7 +197,7 @@ func (s *DnsService) ListTagResources(id string) (object alidns.ListTagResources
 }
 
 func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := getTagsChange(d)
 	added := make([]alidns.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, alidns.TagResourcesTag{
@@ -180,7 +180,7 @@ func (s *EaisServiceV2) EaisInstanceStateRefreshFunc(id string, field string, fa
 
 // SetResourceTags <<< Encapsulated tag function for Eais.
 func (s *EaisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -332,7 +332,7 @@ func (s *EcdService) EcdNetworkPackageRefreshFunc(id string, failStates []string
 
 func (s *EcdService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -3,8 +3,6 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -1600,21 +1598,12 @@ func (s *EcsService) tagsToMap(tags []ecs.Tag) map[string]string {
 }
 
 func (s *EcsService) ecsTagIgnored(t ecs.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -2907,7 +2896,7 @@ func (s *EcsService) DescribeEcsSystemDisk(id string) (object map[string]interfa
 func (s *EcsService) SetInstanceSetResourceTags(d *schema.ResourceData, resourceType string, instanceIds []string) (err error) {
 	var response map[string]interface{}
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
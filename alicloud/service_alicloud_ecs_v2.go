@@ -85,7 +85,7 @@ func (s *EcsServiceV2) EcsImageComponentStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Ecs.
 func (s *EcsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
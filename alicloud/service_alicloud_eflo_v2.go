@@ -372,7 +372,7 @@ func (s *EfloServiceV2) EfloClusterStateRefreshFunc(id string, field string, fai
 
 // SetResourceTags <<< Encapsulated tag function for Eflo.
 func (s *EfloServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -117,7 +117,7 @@ func (s *EipServiceV2) EipAddressStateRefreshFunc(id string, field string, failS
 
 // SetResourceTags <<< Encapsulated tag function for Eip.
 func (s *EipServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
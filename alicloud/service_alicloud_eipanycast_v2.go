@@ -94,7 +94,7 @@ func (s *EipanycastServiceV2) EipanycastAnycastEipAddressStateRefreshFuncWithApi
 
 // SetResourceTags <<< Encapsulated tag function for Eipanycast.
 func (s *EipanycastServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -429,7 +429,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	elasticsearchService := ElasticsearchService{client}
 
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	remove, add := elasticsearchService.diffElasticsearchTags(o, n)
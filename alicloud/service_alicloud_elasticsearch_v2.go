@@ -198,7 +198,7 @@ func (s *ElasticsearchServiceV2) ElasticsearchInstanceStateRefreshFuncWithApi(id
 
 // SetResourceTags <<< Encapsulated tag function for Elasticsearch.
 func (s *ElasticsearchServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
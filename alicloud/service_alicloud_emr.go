@@ -308,8 +308,8 @@ func (s *EmrService) EmrV2ClusterStateRefreshFunc(id string, failStates []string
 }
 
 func (s *EmrService) setEmrClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -459,9 +459,9 @@ func (s *EmrService) ListTagResources(id string, resourceType string) (object in
 }
 
 func (s *EmrService) SetEmrClusterTagsNew(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		client := s.client
-		_, nraw := d.GetChange("tags")
+		_, nraw := getTagsChange(d)
 
 		var createTags []map[string]interface{}
 		newTagMap := nraw.(map[string]interface{})
@@ -580,7 +580,7 @@ func (s *EmrService) ListTagResourcesNew(id string, resourceType string) (object
 
 func (s *EmrService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
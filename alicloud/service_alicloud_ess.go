@@ -938,7 +938,7 @@ func (s *EssService) WaitForEssAlarm(id string, status Status, timeout int) erro
 
 func (s *EssService) SetResourceTags(d *schema.ResourceData, scalingGroupId string, client *connectivity.AliyunClient) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 
 		// untag resources
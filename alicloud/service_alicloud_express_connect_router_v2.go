@@ -361,7 +361,7 @@ func (s *ExpressConnectRouterServiceV2) DescribeAsyncGetExpressConnectRouter(d *
 
 // SetResourceTags <<< Encapsulated tag function for ExpressConnectRouter.
 func (s *ExpressConnectRouterServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
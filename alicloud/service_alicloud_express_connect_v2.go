@@ -859,7 +859,7 @@ func (s *ExpressConnectServiceV2) ExpressConnectBgpNetworkStateRefreshFunc(id st
 
 // SetResourceTags <<< Encapsulated tag function for ExpressConnect.
 func (s *ExpressConnectServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
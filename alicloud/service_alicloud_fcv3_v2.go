@@ -690,7 +690,7 @@ func (s *Fcv3ServiceV2) Fcv3VpcBindingStateRefreshFunc(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for Fcv3.
 func (s *Fcv3ServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -1703,7 +1703,7 @@ func (s *GaService) SetResourceTags(d *schema.ResourceData, resourceType string)
 	client := s.client
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		var response map[string]interface{}
 		var err error
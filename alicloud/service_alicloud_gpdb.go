@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -293,7 +291,7 @@ func (s *GpdbService) WaitForGpdbConnection(id string, status Status, timeout in
 }
 
 func (s *GpdbService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffGpdbTags(gpdbTagsFromMap(o), gpdbTagsFromMap(n))
@@ -347,16 +345,7 @@ func (s *GpdbService) tagsToMap(tags []gpdb.Tag) map[string]string {
 }
 
 func (s *GpdbService) ignoreTag(t gpdb.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *GpdbService) DescribeGpdbAccount(id string) (object map[string]interface{}, err error) {
@@ -476,7 +465,7 @@ func (s *GpdbService) ListTagResources(id string, resourceType string) (object i
 
 func (s *GpdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
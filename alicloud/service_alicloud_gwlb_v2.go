@@ -77,7 +77,7 @@ func (s *GwlbServiceV2) GwlbLoadBalancerStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Gwlb.
 func (s *GwlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
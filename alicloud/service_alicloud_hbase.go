@@ -2,8 +2,6 @@ package alicloud
 
 import (
 	"fmt"
-	"log"
-	"regexp"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -35,7 +33,7 @@ type HBaseService struct {
 }
 
 func (s *HBaseService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -120,16 +118,7 @@ func (s *HBaseService) tagsToMap(tags []hbase.Tag) map[string]string {
 }
 
 func (s *HBaseService) ignoreTag(t hbase.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *HBaseService) DescribeHBaseInstance(id string) (object map[string]interface{}, err error) {
@@ -362,7 +362,7 @@ func (s *HbrServiceV2) HbrVaultStateRefreshFunc(id string, field string, failSta
 
 // SetResourceTags <<< Encapsulated tag function for Hbr.
 func (s *HbrServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
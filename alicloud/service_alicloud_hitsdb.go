@@ -229,7 +229,7 @@ func (s *HitsdbService) ListTagResources(id string, resourceType string) (object
 
 func (s *HitsdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
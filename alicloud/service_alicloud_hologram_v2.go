@@ -94,7 +94,7 @@ func (s *HologramServiceV2) HologramInstanceStateRefreshFunc(id string, field st
 
 // SetResourceTags <<< Encapsulated tag function for Hologram.
 func (s *HologramServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
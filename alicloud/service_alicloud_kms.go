@@ -429,7 +429,7 @@ func (s *KmsService) SetResourceTags(d *schema.ResourceData, resourceType string
 
 	resourceIdNum := strings.Count(d.Id(), ":")
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
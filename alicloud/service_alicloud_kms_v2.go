@@ -620,7 +620,7 @@ func (s *KmsServiceV2) ListTagResources(id string, resourceType string) (object
 // SetResourceTags <<< Encapsulated tag function for Kms.
 func (s *KmsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 	resourceIdNum := strings.Count(d.Id(), ":")
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
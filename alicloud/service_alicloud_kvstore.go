@@ -1,8 +1,6 @@
 package alicloud
 
 import (
-	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -177,8 +175,8 @@ func (s *KvstoreService) ModifyInstanceConfig(id string, config string) error {
 }
 
 func (s *KvstoreService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -246,16 +244,7 @@ func (s *KvstoreService) tagsFromMap(m map[string]interface{}) []r_kvstore.TagRe
 }
 
 func (s *KvstoreService) ignoreTag(t r_kvstore.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagValue)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *KvstoreService) diffTags(oldTags, newTags []r_kvstore.TagResourcesTag) ([]r_kvstore.TagResourcesTag, []r_kvstore.TagResourcesTag) {
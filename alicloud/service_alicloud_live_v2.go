@@ -94,7 +94,7 @@ func (s *LiveServiceV2) LiveCasterStateRefreshFunc(id string, field string, fail
 
 // SetResourceTags <<< Encapsulated tag function for LiveCaster.
 func (s *LiveServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -177,7 +177,7 @@ func (s *LiveServiceV2) SetResourceTags(d *schema.ResourceData, resourceType str
 }
 
 func (s *LiveServiceV2) SetLiveResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -125,7 +125,7 @@ func (s *MaxComputeServiceV2) MaxComputeProjectStateRefreshFunc(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for MaxCompute.
 func (s *MaxComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -107,7 +107,7 @@ func (s *MessageServiceServiceV2) DescribeMessageServiceTopic(id string) (object
 
 // SetResourceTags <<< Encapsulated tag function for MessageService.
 func (s *MessageServiceServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -98,7 +98,7 @@ func (s *MilvusServiceV2) MilvusInstanceStateRefreshFuncWithApi(id string, field
 
 // SetResourceTags <<< Encapsulated tag function for Milvus.
 func (s *MilvusServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -3,8 +3,6 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -784,7 +782,7 @@ func (s *MongoDBService) ResetAccountPassword(d *schema.ResourceData, password s
 }
 
 func (s *MongoDBService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -839,16 +837,7 @@ func (s *MongoDBService) tagsToMap(tags []dds.Tag) map[string]string {
 }
 
 func (s *MongoDBService) ignoreTag(t dds.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *MongoDBService) tagsInAttributeToMap(tags []dds.Tag) map[string]string {
@@ -862,16 +851,7 @@ func (s *MongoDBService) tagsInAttributeToMap(tags []dds.Tag) map[string]string
 }
 
 func (s *MongoDBService) ignoreTagInAttribute(t dds.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *MongoDBService) diffTags(oldTags, newTags []dds.TagResourcesTag) ([]dds.TagResourcesTag, []dds.TagResourcesTag) {
@@ -1094,7 +1074,7 @@ func (s *MongoDBService) MongodbServerlessInstanceStateRefreshFunc(id string, fa
 }
 
 func (s *MongoDBService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 		removedTagKeys := make([]string, 0)
@@ -397,7 +397,7 @@ func (s *NasService) ListTagResources(id string, resourceType string) (object in
 
 func (s *NasService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
@@ -436,7 +436,7 @@ func (s *NasServiceV2) DescribeAsyncNasFileSystemStateRefreshFunc(d *schema.Reso
 
 // SetResourceTags <<< Encapsulated tag function for Nas.
 func (s *NasServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
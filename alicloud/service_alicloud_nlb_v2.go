@@ -375,7 +375,7 @@ func (s *NlbServiceV2) NlbSecurityPolicyStateRefreshFunc(id string, field string
 
 // SetResourceTags <<< Encapsulated tag function for Nlb.
 func (s *NlbServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -167,7 +167,7 @@ func (s *OnsService) SetResourceTags(d *schema.ResourceData, resourceType string
 		}
 	}
 	client := s.client
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		removedTagKeys := make([]string, 0)
 		for _, v := range removed {
@@ -92,7 +92,7 @@ func (s *OosServiceV2) OosPatchBaselineStateRefreshFuncWithApi(id string, field
 // DescribeOosPatchBaseline >>> Encapsulated.
 
 func (s *OosServiceV2) SetOssResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -280,7 +280,7 @@ func (s *OosServiceV2) OosSecretParameterStateRefreshFunc(id string, field strin
 
 // SetResourceTags <<< Encapsulated tag function for Oos.
 func (s *OosServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -164,7 +164,7 @@ func (s *PaiServiceV2) PaiTrainingJobStateRefreshFunc(id string, field string, f
 
 // SetResourceTags <<< Encapsulated tag function for Pai.
 func (s *PaiServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
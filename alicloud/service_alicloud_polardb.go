@@ -3,8 +3,6 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -1171,8 +1169,8 @@ func (s *PolarDBService) CreateClusterParamsModifyParameters(d *schema.ResourceD
 }
 
 func (s *PolarDBService) setClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1262,16 +1260,7 @@ func (s *PolarDBService) tagsFromMap(m map[string]interface{}) []polardb.TagReso
 }
 
 func (s *PolarDBService) ignoreTag(t polardb.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *PolarDBService) DescribeTags(resourceId string, resourceType TagResourceType) (tags []polardb.TagResource, err error) {
@@ -119,7 +119,7 @@ func (s *PrivateLinkServiceV2) PrivateLinkVpcEndpointServiceStateRefreshFunc(id
 
 // SetResourceTags <<< Encapsulated tag function for PrivateLink.
 func (s *PrivateLinkServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
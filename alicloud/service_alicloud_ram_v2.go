@@ -659,7 +659,7 @@ func (s *RamServiceV2) ParsePolicyDocument(policyDocument string) (statement []m
 
 // SetResourceTags <<< Encapsulated tag function for Ram.
 func (s *RamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
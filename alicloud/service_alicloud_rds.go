@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -1773,7 +1772,7 @@ func (s *RdsService) flattenDBSecurityIPs(list []interface{}) []map[string]inter
 }
 
 func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 		var err error
@@ -1906,16 +1905,7 @@ func (s *RdsService) tagsToMap(tags []Tag) map[string]string {
 }
 
 func (s *RdsService) ignoreTag(t Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func (s *RdsService) tagsToString(tags []Tag) string {
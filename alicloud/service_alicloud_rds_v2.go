@@ -164,7 +164,7 @@ func (s *RdsServiceV2) RdsCustomStateRefreshFuncWithApi(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for Rds.
 func (s *RdsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
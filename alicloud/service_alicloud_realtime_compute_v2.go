@@ -85,7 +85,7 @@ func (s *RealtimeComputeServiceV2) RealtimeComputeVvpInstanceStateRefreshFunc(id
 
 // SetResourceTags <<< Encapsulated tag function for RealtimeCompute.
 func (s *RealtimeComputeServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -352,7 +352,7 @@ func (s *RedisServiceV2) RedisTairInstanceStateRefreshFunc(id string, field stri
 
 // SetResourceTags <<< Encapsulated tag function for Redis.
 func (s *RedisServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -259,7 +259,7 @@ func (s *ResourceManagerServiceV2) DescribeAsyncResourceManagerAccountStateRefre
 
 // SetResourceTags <<< Encapsulated tag function for ResourceManager.
 func (s *ResourceManagerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -1467,7 +1467,7 @@ func (s *ResourceManagerServiceV2) ResourceManagerResourceShareStateRefreshFuncW
 // DescribeResourceManagerResourceShare >>> Encapsulated.
 
 func (s *ResourceManagerServiceV2) SetResourceTagsForResourceSharing(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -175,7 +175,7 @@ func (s *RocketmqServiceV2) DescribeInstanceGetInstanceIpWhitelist(id string) (o
 
 // SetResourceTags <<< Encapsulated tag function for Rocketmq.
 func (s *RocketmqServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
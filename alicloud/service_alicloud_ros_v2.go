@@ -157,7 +157,7 @@ func (s *RosServiceV2) RosStackGroupStateRefreshFunc(id string, field string, fa
 
 // SetResourceTags <<< Encapsulated tag function for Ros.
 func (s *RosServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
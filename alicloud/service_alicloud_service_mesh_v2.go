@@ -184,7 +184,7 @@ func (s *ServiceMeshServiceV2) ServiceMeshServiceMeshStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for ServiceMesh.
 func (s *ServiceMeshServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
@@ -3,8 +3,6 @@ package alicloud
 import (
 	"encoding/json"
 	"fmt"
-	"log"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -682,7 +680,7 @@ func (s *SlbService) WaitForSlbDomainExtension(id string, status Status, timeout
 }
 
 func (s *SlbService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -763,16 +761,7 @@ func (s *SlbService) tagsToMap(tags []slb.TagResource) map[string]string {
 }
 
 func (s *SlbService) ignoreTag(t slb.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific t %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func (s *SlbService) diffTags(oldTags, newTags []slb.TagResourcesTag) ([]slb.TagResourcesTag, []slb.TagResourcesTag) {
@@ -854,7 +843,7 @@ func (s *SlbService) ListTagResources(id string, resourceType string) (object in
 
 func (s *SlbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
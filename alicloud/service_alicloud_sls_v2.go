@@ -114,7 +114,7 @@ func (s *SlsServiceV2) SlsProjectStateRefreshFunc(id string, field string, failS
 
 // SetResourceTags <<< Encapsulated tag function for Sls.
 func (s *SlsServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
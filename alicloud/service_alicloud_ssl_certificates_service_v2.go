@@ -162,7 +162,7 @@ func (s *SslCertificatesServiceServiceV2) SslCertificatesServiceCertificateState
 
 // SetResourceTags <<< Encapsulated tag function for SslCertificates.
 func (s *SslCertificatesServiceServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -100,7 +100,7 @@ func (s *StarRocksServiceV2) StarRocksInstanceStateRefreshFunc(id string, field
 // DescribeStarRocksInstance >>> Encapsulated.
 // SetResourceTags <<< Encapsulated tag function for StarRocks.
 func (s *StarRocksServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
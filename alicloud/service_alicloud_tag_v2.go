@@ -160,3 +160,70 @@ func (s *TagServiceV2) TagAssociatedRuleStateRefreshFunc(id string, field string
 }
 
 // DescribeTagAssociatedRule >>> Encapsulated.
+
+// ListTagResourcesByArn returns the tags of the resources, keyed by their ARNs like
+// acs:ecs:cn-hangzhou:123456789:instance/i-abc123. The resources without tags are not returned.
+func (s *TagServiceV2) ListTagResourcesByArn(arns []string) (tags map[string]map[string]string, err error) {
+	client := s.client
+	var response map[string]interface{}
+	action := "ListTagResources"
+	tags = make(map[string]map[string]string)
+	// At most 50 ARNs can be queried in a request.
+	for start := 0; start < len(arns); start += PageSizeLarge {
+		end := start + PageSizeLarge
+		if end > len(arns) {
+			end = len(arns)
+		}
+		request := make(map[string]interface{})
+		request["RegionId"] = client.RegionId
+		request["PageSize"] = 1000
+		for i, arn := range arns[start:end] {
+			request[fmt.Sprintf("ResourceARN.%d", i+1)] = arn
+		}
+		for {
+			wait := incrementalWait(3*time.Second, 5*time.Second)
+			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+				response, err = client.RpcPost("Tag", "2018-08-28", action, nil, request, true)
+				if err != nil {
+					if NeedRetry(err) {
+						wait()
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				return nil
+			})
+			addDebug(action, response, request)
+			if err != nil {
+				return tags, WrapErrorf(err, DefaultErrorMsg, strings.Join(arns[start:end], ","), action, AlibabaCloudSdkGoERROR)
+			}
+			v, err := jsonpath.Get("$.TagResources", response)
+			if err != nil {
+				return tags, WrapErrorf(err, FailedGetAttributeMsg, action, "$.TagResources", response)
+			}
+			result, _ := v.([]interface{})
+			for _, item := range result {
+				object, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				arn := fmt.Sprint(object["ResourceARN"])
+				if _, ok := tags[arn]; !ok {
+					tags[arn] = make(map[string]string)
+				}
+				resourceTags, _ := object["Tags"].([]interface{})
+				for _, resourceTag := range resourceTags {
+					if tag, ok := resourceTag.(map[string]interface{}); ok {
+						tags[arn][fmt.Sprint(tag["Key"])] = fmt.Sprint(tag["Value"])
+					}
+				}
+			}
+			if nextToken, ok := response["NextToken"].(string); ok && nextToken != "" {
+				request["NextToken"] = nextToken
+			} else {
+				break
+			}
+		}
+	}
+	return tags, nil
+}
@@ -70,7 +70,7 @@ func (s *VodService) VodStateRefreshFunc(id string, failStates []string) resourc
 
 func (s *VodService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		added, removed := parsingTags(d)
 		client := s.client
 
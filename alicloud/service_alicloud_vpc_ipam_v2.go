@@ -96,7 +96,7 @@ func (s *VpcIpamServiceV2) VpcIpamIpamStateRefreshFunc(id string, field string,
 
 // SetResourceTags <<< Encapsulated tag function for VpcIpam.
 func (s *VpcIpamServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -84,7 +84,7 @@ func (s *VpcPeerServiceV2) VpcPeerPeerConnectionStateRefreshFunc(id string, fiel
 
 // SetResourceTags <<< Encapsulated tag function for VpcPeer.
 func (s *VpcPeerServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		var request map[string]interface{}
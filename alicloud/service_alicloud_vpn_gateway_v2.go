@@ -344,7 +344,7 @@ func (s *VPNGatewayServiceV2) VpnGatewayVpnAttachmentStateRefreshFunc(id string,
 
 // SetResourceTags <<< Encapsulated tag function for VpnGateway.
 func (s *VPNGatewayServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -261,7 +261,7 @@ func (s *Wafv3ServiceV2) Wafv3DomainStateRefreshFuncWithApi(id string, field str
 
 // SetResourceTags <<< Encapsulated tag function for Wafv3.
 func (s *Wafv3ServiceV2) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var action string
 		var err error
 		client := s.client
@@ -329,7 +329,7 @@ func (s *DbauditService) DescribeTags(id string, resourceType string) (object in
 }
 
 func (s *DbauditService) setInstanceTags(d *schema.ResourceData, resourceType string) (err error) {
-	if d.HasChange("tags") {
+	if hasTagsChange(d) {
 		var err error
 		var action string
 		client := s.client
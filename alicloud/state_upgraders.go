@@ -0,0 +1,127 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// stateUpgradeStep rewrites the raw state of a resource in place. The raw state is the JSON state which is
+// decoded as a map, so the nested blocks are []interface{} of map[string]interface{}.
+type stateUpgradeStep func(rawState map[string]interface{}) error
+
+// stateUpgrade upgrades the raw state of one schema version to the next one by running the steps in order.
+type stateUpgrade []stateUpgradeStep
+
+// withStateUpgrades sets the schema version of the resource to the count of the upgrades and registers the
+// upgrades as its state upgraders, the first upgrade handles the version 0. The states of the old versions are
+// decoded by the current schema, so an upgrade can only rewrite the values, like moving the values of the deprecated
+// attributes to the new ones. The schema of the old version should be kept in the resource file as soon as an
+// attribute is removed from the schema.
+func withStateUpgrades(resource *schema.Resource, upgrades ...stateUpgrade) *schema.Resource {
+	stateType := resource.CoreConfigSchema().ImpliedType()
+	for version, upgrade := range upgrades {
+		resource.StateUpgraders = append(resource.StateUpgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    stateType,
+			Upgrade: upgrade.upgradeFunc(version),
+		})
+	}
+	resource.SchemaVersion = len(upgrades)
+	return resource
+}
+
+func (upgrade stateUpgrade) upgradeFunc(version int) schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if rawState == nil {
+			return rawState, nil
+		}
+		for _, step := range upgrade {
+			if err := step(rawState); err != nil {
+				return rawState, fmt.Errorf("upgrading the state %v of the schema version %d failed: %v", rawState["id"], version, err)
+			}
+		}
+		log.Printf("[DEBUG] The state %v has been upgraded from the schema version %d to %d.", rawState["id"], version, version+1)
+		return rawState, nil
+	}
+}
+
+// renameStateAttribute moves the value of the deprecated attribute from to its new attribute to if the new one has
+// no value. The deprecated attribute is kept, because the Read still sets it while it is in the schema.
+func renameStateAttribute(from, to string) stateUpgradeStep {
+	return convertStateAttribute(from, to, nil)
+}
+
+// convertStateAttribute sets the attribute to by the value of the attribute from which is converted by the convert
+// function, like convertChargeTypeToPaymentType. The from and to can be the same attribute to map its value in place,
+// otherwise the attribute to is only set if it has no value. A nil convert function keeps the value as it is.
+func convertStateAttribute(from, to string, convert func(interface{}) interface{}) stateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		value, ok := rawState[from]
+		if !ok || isEmptyStateValue(value) {
+			return nil
+		}
+		if from != to && !isEmptyStateValue(rawState[to]) {
+			return nil
+		}
+		if convert != nil {
+			value = convert(value)
+		}
+		rawState[to] = value
+		return nil
+	}
+}
+
+// mapStateAttributeValue is a convertStateAttribute which converts the value by the mapping, whose keys are the
+// string forms of the values, like "true" of a bool. The value which isn't in the mapping is not converted.
+func mapStateAttributeValue(from, to string, mapping map[string]interface{}) stateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		value, ok := rawState[from]
+		if !ok || isEmptyStateValue(value) {
+			return nil
+		}
+		if _, ok := mapping[fmt.Sprint(value)]; !ok {
+			return nil
+		}
+		return convertStateAttribute(from, to, func(v interface{}) interface{} {
+			return mapping[fmt.Sprint(v)]
+		})(rawState)
+	}
+}
+
+// formatStateId rebuilds the id by joining the values of the attributes with the separator if the id doesn't have a
+// part for each of them, like the id of a resource which is changed to a composite id.
+func formatStateId(separator string, attributes ...string) stateUpgradeStep {
+	return func(rawState map[string]interface{}) error {
+		id := fmt.Sprint(rawState["id"])
+		if rawState["id"] != nil && id != "" && len(strings.Split(id, separator)) == len(attributes) {
+			return nil
+		}
+		parts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			value := rawState[attribute]
+			if isEmptyStateValue(value) {
+				return fmt.Errorf("the attribute %s is required to format the id %q", attribute, id)
+			}
+			parts = append(parts, fmt.Sprint(value))
+		}
+		rawState["id"] = strings.Join(parts, separator)
+		return nil
+	}
+}
+
+func isEmptyStateValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCommonRenameStateAttribute(t *testing.T) {
+	rawState := map[string]interface{}{"name": "tf-test"}
+	assert.Nil(t, renameStateAttribute("name", "security_group_name")(rawState))
+	assert.Equal(t, "tf-test", rawState["security_group_name"])
+	assert.Equal(t, "tf-test", rawState["name"], "the deprecated attribute should be kept")
+
+	rawState = map[string]interface{}{"name": "tf-old", "security_group_name": "tf-new"}
+	assert.Nil(t, renameStateAttribute("name", "security_group_name")(rawState))
+	assert.Equal(t, "tf-new", rawState["security_group_name"], "the new attribute with a value should not be overwritten")
+
+	rawState = map[string]interface{}{"name": "", "security_group_name": nil}
+	assert.Nil(t, renameStateAttribute("name", "security_group_name")(rawState))
+	assert.Nil(t, rawState["security_group_name"])
+}
+
+func TestUnitCommonConvertStateAttribute(t *testing.T) {
+	rawState := map[string]interface{}{"instance_charge_type": "PostPaid"}
+	assert.Nil(t, convertStateAttribute("instance_charge_type", "payment_type", convertChargeTypeToPaymentType)(rawState))
+	assert.Equal(t, "PayAsYouGo", rawState["payment_type"])
+	assert.Equal(t, "PostPaid", rawState["instance_charge_type"])
+
+	rawState = map[string]interface{}{"payment_type": "Prepaid"}
+	assert.Nil(t, convertStateAttribute("payment_type", "payment_type", convertChargeTypeToPaymentType)(rawState))
+	assert.Equal(t, "Subscription", rawState["payment_type"], "the value should be mapped in place")
+
+	rawState = map[string]interface{}{"inner_access": true}
+	assert.Nil(t, mapStateAttributeValue("inner_access", "inner_access_policy", map[string]interface{}{"true": "Accept", "false": "Drop"})(rawState))
+	assert.Equal(t, "Accept", rawState["inner_access_policy"])
+
+	rawState = map[string]interface{}{"status": "Unknown"}
+	assert.Nil(t, mapStateAttributeValue("status", "status", map[string]interface{}{"Running": "Active"})(rawState))
+	assert.Equal(t, "Unknown", rawState["status"], "the value out of the mapping should not be converted")
+}
+
+func TestUnitCommonFormatStateId(t *testing.T) {
+	rawState := map[string]interface{}{"id": "tf-store", "project_name": "tf-project", "logstore_name": "tf-store"}
+	assert.Nil(t, formatStateId(COLON_SEPARATED, "project_name", "logstore_name")(rawState))
+	assert.Equal(t, "tf-project:tf-store", rawState["id"])
+
+	rawState = map[string]interface{}{"id": "tf-project:tf-store", "project_name": "tf-other", "logstore_name": "tf-store"}
+	assert.Nil(t, formatStateId(COLON_SEPARATED, "project_name", "logstore_name")(rawState))
+	assert.Equal(t, "tf-project:tf-store", rawState["id"], "the id in the format should not be changed")
+
+	rawState = map[string]interface{}{"id": "tf-store", "logstore_name": "tf-store"}
+	assert.NotNil(t, formatStateId(COLON_SEPARATED, "project_name", "logstore_name")(rawState))
+	assert.Equal(t, "tf-store", rawState["id"])
+}
+
+func TestUnitCommonWithStateUpgrades(t *testing.T) {
+	resource := withStateUpgrades(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"policy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"payment_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	},
+		stateUpgrade{renameStateAttribute("name", "policy_name")},
+		stateUpgrade{convertStateAttribute("payment_type", "payment_type", convertChargeTypeToPaymentType)},
+	)
+	assert.Equal(t, 2, resource.SchemaVersion)
+	assert.Len(t, resource.StateUpgraders, 2)
+	assert.Nil(t, resource.InternalValidate(nil, true))
+
+	rawState := map[string]interface{}{"id": "tf-test", "name": "tf-test", "payment_type": "PrePaid"}
+	for _, upgrader := range resource.StateUpgraders {
+		var err error
+		rawState, err = upgrader.Upgrade(rawState, nil)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, "tf-test", rawState["policy_name"])
+	assert.Equal(t, "Subscription", rawState["payment_type"])
+
+	failed := withStateUpgrades(&schema.Resource{Schema: map[string]*schema.Schema{}}, stateUpgrade{formatStateId(COLON_SEPARATED, "project_name", "logstore_name")})
+	_, err := failed.StateUpgraders[0].Upgrade(map[string]interface{}{"id": "tf-test"}, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "schema version 0")
+}
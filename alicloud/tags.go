@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
@@ -78,12 +79,15 @@ func parsingTemplateTags(d *schema.ResourceData) (map[string]interface{}, []stri
 }
 
 func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := getTagsChange(d)
 	removedTags := oraw.(map[string]interface{})
 	addedTags := nraw.(map[string]interface{})
 	// Build the list of what to remove
 	removed := make([]string, 0)
 	for key, value := range removedTags {
+		if providerIgnoreTags.ignored(key) {
+			continue
+		}
 		old, ok := addedTags[key]
 		if !ok || old != value {
 			// Delete it!
@@ -94,6 +98,128 @@ func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
 	return addedTags, removed
 }
 
+// hasTagsChange reports whether the resource tags have changed, including the changes coming from the provider default_tags.
+func hasTagsChange(d *schema.ResourceData) bool {
+	return d.HasChange("tags") || d.HasChange("tags_all")
+}
+
+// getTagsChange returns the old and new tags of the resource. If the resource supports the provider default_tags,
+// the returned tags have been merged with the default tags.
+func getTagsChange(d *schema.ResourceData) (interface{}, interface{}) {
+	if _, ok := d.Get("tags_all").(map[string]interface{}); ok {
+		return d.GetChange("tags_all")
+	}
+	return d.GetChange("tags")
+}
+
+// mergeDefaultTags merges the provider default_tags and the resource tags, and the resource tags win on conflict.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for key, value := range defaultTags {
+		result[key] = value
+	}
+	for key, value := range tags {
+		result[key] = value
+	}
+	return result
+}
+
+// resourceWithDefaultTags attaches the computed tags_all to a taggable resource and wires up the provider default_tags.
+// The plan shows the merged tags in tags_all, the merged tags are sent on create and update, and the tags inherited
+// from default_tags are not reported as drift after reading.
+func resourceWithDefaultTags(r *schema.Resource) {
+	tagsSchema, ok := r.Schema["tags"]
+	if !ok || tagsSchema.Type != schema.TypeMap || !tagsSchema.Optional || tagsSchema.ForceNew || r.Update == nil {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(diff, meta); err != nil {
+				return err
+			}
+		}
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+		tags, _ := diff.Get("tags").(map[string]interface{})
+		return diff.SetNew("tags_all", mergeDefaultTags(providerDefaultTags(meta), tags))
+	}
+
+	create, read, update := r.Create, r.Read, r.Update
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		_, configured := d.GetChange("tags")
+		if defaultTags := providerDefaultTags(meta); len(defaultTags) > 0 {
+			if err := d.Set("tags", mergeDefaultTags(defaultTags, configured.(map[string]interface{}))); err != nil {
+				return WrapError(err)
+			}
+		}
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		return setTagsAll(d, meta, configured.(map[string]interface{}))
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configured, _ := d.Get("tags").(map[string]interface{})
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		return setTagsAll(d, meta, configured)
+	}
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		_, configured := d.GetChange("tags")
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		return setTagsAll(d, meta, configured.(map[string]interface{}))
+	}
+}
+
+// setTagsAll saves the tags read from the resource into tags_all, and removes the tags which come from the
+// provider default_tags and are not configured in the resource tags.
+func setTagsAll(d *schema.ResourceData, meta interface{}, configured map[string]interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	tags, ok := d.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	if err := d.Set("tags_all", tags); err != nil {
+		return WrapError(err)
+	}
+	defaultTags := providerDefaultTags(meta)
+	if len(defaultTags) < 1 {
+		return nil
+	}
+	result := make(map[string]interface{})
+	for key, value := range tags {
+		if defaultValue, ok := defaultTags[key]; ok && defaultValue == value {
+			if _, ok := configured[key]; !ok {
+				continue
+			}
+		}
+		result[key] = value
+	}
+	return d.Set("tags", result)
+}
+
+func providerDefaultTags(meta interface{}) map[string]interface{} {
+	if client, ok := meta.(*connectivity.AliyunClient); ok {
+		return client.GetDefaultTags()
+	}
+	return nil
+}
+
 func tagsToMap(tags interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 	if tags == nil {
@@ -153,23 +279,73 @@ func tagsToMap(tags interface{}) map[string]interface{} {
 }
 
 func tagIgnored(tagKey string, tagValue interface{}) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://", "^sae.do.not.delete"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, tagKey)
-		ok, _ := regexp.MatchString(v, tagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", tagKey, tagValue)
+	return tagKeyIgnored(tagKey, tagValue, "aliyun", "acs:", "http://", "https://", "sae.do.not.delete")
+}
+
+// reservedTagKeyPrefixes are the tag key prefixes reserved by Alibaba Cloud, and the tags with them cannot be managed by Terraform.
+var reservedTagKeyPrefixes = []string{"aliyun", "acs:", "http://", "https://"}
+
+// providerIgnoreTags stores the provider ignore_tags. It is set when configuring the provider and it is consulted by
+// all of tag converters, so that the tags managed externally are neither read into state nor removed on update.
+var providerIgnoreTags = &ignoreTagsConfig{}
+
+type ignoreTagsConfig struct {
+	keys        map[string]struct{}
+	keyPrefixes []string
+}
+
+func newIgnoreTagsConfig(keys, keyPrefixes []string) *ignoreTagsConfig {
+	config := &ignoreTagsConfig{
+		keys: make(map[string]struct{}, len(keys)),
+	}
+	for _, key := range keys {
+		if key != "" {
+			config.keys[key] = struct{}{}
+		}
+	}
+	for _, prefix := range keyPrefixes {
+		if prefix != "" {
+			config.keyPrefixes = append(config.keyPrefixes, prefix)
+		}
+	}
+	return config
+}
+
+func (c *ignoreTagsConfig) ignored(tagKey string) bool {
+	if c == nil {
+		return false
+	}
+	if _, ok := c.keys[tagKey]; ok {
+		return true
+	}
+	for _, prefix := range c.keyPrefixes {
+		if strings.HasPrefix(tagKey, prefix) {
 			return true
 		}
 	}
 	return false
 }
 
+// tagKeyIgnored checks whether the tag should be ignored by the builtin key prefixes and the provider ignore_tags.
+func tagKeyIgnored(tagKey string, tagValue interface{}, builtinPrefixes ...string) bool {
+	for _, prefix := range builtinPrefixes {
+		if strings.HasPrefix(tagKey, prefix) {
+			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %v), ignoring.\n", tagKey, tagValue)
+			return true
+		}
+	}
+	if providerIgnoreTags.ignored(tagKey) {
+		log.Printf("[DEBUG] Found tag %s (val: %v) matching the provider ignore_tags, ignoring.\n", tagKey, tagValue)
+		return true
+	}
+	return false
+}
+
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -177,8 +353,8 @@ func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d
 }
 
 func setCdnTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if hasTagsChange(d) {
+		oraw, nraw := getTagsChange(d)
 		return updateCdnTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
@@ -453,6 +629,9 @@ func diffTags(oldTags, newTags []Tag) ([]Tag, []Tag) {
 	// Build the list of what to remove
 	var remove []Tag
 	for _, t := range oldTags {
+		if providerIgnoreTags.ignored(t.Key) {
+			continue
+		}
 		old, ok := create[t.Key]
 		if !ok || old != t.Value {
 			// Delete it!
@@ -576,7 +755,9 @@ func essTagsToMap(tags []ess.Tag) map[string]string {
 func otsTagsToMap(tags []ots.TagInfo) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
-		result[t.TagKey] = t.TagValue
+		if !tagKeyIgnored(t.TagKey, t.TagValue) {
+			result[t.TagKey] = t.TagValue
+		}
 	}
 
 	return result
@@ -585,7 +766,9 @@ func otsTagsToMap(tags []ots.TagInfo) map[string]string {
 func otsRestTagsToMap(tags []RestOtsTagInfo) map[string]string {
 	result := make(map[string]string)
 	for _, t := range tags {
-		result[t.Key] = t.Value
+		if !tagKeyIgnored(t.Key, t.Value) {
+			result[t.Key] = t.Value
+		}
 	}
 
 	return result
@@ -652,105 +835,34 @@ func tagsMapEqual(expectMap map[string]interface{}, compareMap map[string]string
 
 // tagIgnored compares a tag against a list of strings and checks if it should be ignored or not
 func ecsTagIgnored(t ecs.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func vpcTagIgnored(t vpc.Tag) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 // tagIgnored compares a tag against a list of strings and checks if it should be ignored or not
 func essTagIgnored(t ess.Tag) bool {
-	filter := []string{"^aliyun", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, "aliyun", "http://", "https://")
 }
 
 func cdnTagIgnored(t cdn.TagItem) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.Key)
-		ok, _ := regexp.MatchString(v, t.Key)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.Key, t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.Key, t.Value, reservedTagKeyPrefixes...)
 }
 
 func slbTagIgnored(t slb.TagResource) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, t.TagKey)
-		ok, _ := regexp.MatchString(v, t.TagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", t.TagKey, t.TagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(t.TagKey, t.TagValue, reservedTagKeyPrefixes...)
 }
 
 func albTagIgnored(tagKey string, tagValue interface{}) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://", "^ack", "^ingress"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, tagKey)
-		ok, _ := regexp.MatchString(v, tagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", tagKey, tagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(tagKey, tagValue, "aliyun", "acs:", "http://", "https://", "ack", "ingress")
 }
 
 func elasticsearchTagIgnored(tagKey, tagValue string) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching prefix %v with %v\n", v, tagKey)
-		ok, _ := regexp.MatchString(v, tagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag %s (val: %s), ignoring.\n", tagKey, tagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(tagKey, tagValue, reservedTagKeyPrefixes...)
 }
 
 func ignoredTags(tagKey string, tagValue interface{}) bool {
-	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
-	for _, v := range filter {
-		ok, _ := regexp.MatchString(v, tagKey)
-		if ok {
-			log.Printf("[DEBUG] Found Alibaba Cloud specific tag with key: %s and value: %s, ignoring.\n", tagKey, tagValue)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnored(tagKey, tagValue, reservedTagKeyPrefixes...)
 }
@@ -1,7 +1,11 @@
 package alicloud
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 func TestTagsMapEqual(t *testing.T) {
@@ -48,3 +52,136 @@ func TestTagsMapEqual(t *testing.T) {
 		t.Fatal("Tag maps is equal.")
 	}
 }
+
+func TestUnitTagsMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"Owner": "platform",
+		"Env":   "dev",
+	}
+	tags := map[string]interface{}{
+		"Env":  "prod",
+		"Name": "tf-test",
+	}
+	expected := map[string]interface{}{
+		"Owner": "platform",
+		"Env":   "prod",
+		"Name":  "tf-test",
+	}
+	if result := mergeDefaultTags(defaultTags, tags); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("mergeDefaultTags() = %v, want %v", result, expected)
+	}
+	if result := mergeDefaultTags(nil, tags); !reflect.DeepEqual(result, tags) {
+		t.Fatalf("mergeDefaultTags() = %v, want %v", result, tags)
+	}
+}
+
+func TestUnitTagsResourceWithDefaultTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return d.Set("tags", map[string]interface{}{
+				"Owner": "platform",
+				"Name":  "tf-test",
+				"Env":   "dev",
+			})
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	resourceWithDefaultTags(r)
+	if _, ok := r.Schema["tags_all"]; !ok {
+		t.Fatal("tags_all is not attached to the taggable resource.")
+	}
+
+	config := &connectivity.Config{
+		AccessKey:            "fake-access-key",
+		SecretKey:            "fake-secret-key",
+		Region:               connectivity.Hangzhou,
+		RegionId:             string(connectivity.Hangzhou),
+		AccountType:          "Domestic",
+		SkipRegionValidation: true,
+		DefaultTags: map[string]interface{}{
+			"Owner": "platform",
+			"Env":   "dev",
+		},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The tag Env is configured explicitly with the same value as the default one, so it should be kept.
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name": "tf-test",
+			"Env":  "dev",
+		},
+	})
+	d.SetId("tf-test")
+	if err := r.Read(d, client); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"Name": "tf-test",
+		"Env":  "dev",
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("tags = %v, want %v", tags, expected)
+	}
+	if tagsAll := d.Get("tags_all").(map[string]interface{}); len(tagsAll) != 3 {
+		t.Fatalf("tags_all = %v, want 3 tags", tagsAll)
+	}
+
+	forceNew := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchemaForceNew(),
+		},
+	}
+	resourceWithDefaultTags(forceNew)
+	if _, ok := forceNew.Schema["tags_all"]; ok {
+		t.Fatal("tags_all should not be attached to the resource whose tags are force new.")
+	}
+}
+
+func TestUnitTagsIgnoreTags(t *testing.T) {
+	origin := providerIgnoreTags
+	defer func() {
+		providerIgnoreTags = origin
+	}()
+
+	providerIgnoreTags = newIgnoreTagsConfig([]string{"cmdb-id"}, []string{"ack.", "k8s.io/"})
+	tests := []struct {
+		key      string
+		expected bool
+	}{
+		{"aliyun-system", true},
+		{"acs:rm:rgId", true},
+		{"cmdb-id", true},
+		{"cmdb-ids", false},
+		{"ack.aliyun.com", true},
+		{"k8s.io/cluster", true},
+		{"Name", false},
+	}
+	for _, tt := range tests {
+		if result := tagKeyIgnored(tt.key, "", reservedTagKeyPrefixes...); result != tt.expected {
+			t.Errorf("tagKeyIgnored(%q) = %v, want %v", tt.key, result, tt.expected)
+		}
+	}
+
+	tags := tagsToMap([]interface{}{
+		map[string]interface{}{"TagKey": "Name", "TagValue": "tf-test"},
+		map[string]interface{}{"TagKey": "cmdb-id", "TagValue": "123"},
+		map[string]interface{}{"TagKey": "ack.aliyun.com", "TagValue": "c-123"},
+	})
+	if !reflect.DeepEqual(tags, map[string]interface{}{"Name": "tf-test"}) {
+		t.Fatalf("tagsToMap() = %v, want only the tag Name", tags)
+	}
+
+	create, remove := diffTags([]Tag{{Key: "cmdb-id", Value: "123"}, {Key: "Name", Value: "old"}}, []Tag{{Key: "Name", Value: "new"}})
+	if len(create) != 1 || len(remove) != 1 || remove[0].Key != "Name" {
+		t.Fatalf("diffTags() = %v, %v, the ignored tag should not be removed", create, remove)
+	}
+}
@@ -2,15 +2,19 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
@@ -32,6 +36,8 @@ func init() {
 var (
 	fileNames    = flag.String("fileNames", "", "the files to check diff")
 	resourceName = flag.String("resource", "", "directly specify a resource name to check (e.g., alicloud_ecs_instance)")
+	reportFile   = flag.String("report", "", "the path to write the machine-readable report of the checked resources in JSON")
+	reports      []ResourceReport
 )
 
 func main() {
@@ -44,6 +50,7 @@ func main() {
 		if !checkSingleResource(*resourceName) {
 			exitCode = 1
 		}
+		writeReport()
 		os.Exit(exitCode)
 	}
 
@@ -71,9 +78,28 @@ func main() {
 		}
 	}
 
+	writeReport()
 	os.Exit(exitCode)
 }
 
+// writeReport writes the reports of the checked resources to the report file if it is set
+func writeReport() {
+	if reportFile == nil || *reportFile == "" {
+		return
+	}
+	if reports == nil {
+		reports = []ResourceReport{}
+	}
+	content, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		log.Errorf("Failed to marshal the report: %v", err)
+		return
+	}
+	if err := ioutil.WriteFile(*reportFile, content, 0644); err != nil {
+		log.Errorf("Failed to write the report %s: %v", *reportFile, err)
+	}
+}
+
 // checkSingleResource checks a single resource by its full name (e.g., "alicloud_ecs_instance")
 func checkSingleResource(fullName string) bool {
 	// Remove alicloud_ prefix if present
@@ -106,6 +132,7 @@ func checkResourceBreakingChange(filePath, oldRevision, newRevision string) bool
 				log.Warningf("Cannot get new version of %s: %v", filePath, err)
 				return false
 			}
+			reports = append(reports, newResourceReport(filePath))
 			return checkNewResourceRequiredFields(newContent)
 		}
 		log.Warningf("Cannot get old version of %s: %v", filePath, err)
@@ -118,16 +145,20 @@ func checkResourceBreakingChange(filePath, oldRevision, newRevision string) bool
 	}
 
 	// Parse schemas using AST
-	oldAttrs := ParseSchemaFromAST(oldContent)
-	newAttrs := ParseSchemaFromAST(newContent)
-	schemaBreaking := IsBreakingChange(oldAttrs, newAttrs)
+	report := newResourceReport(filePath)
+	report.Changes = append(report.Changes, CompareSchemas(ParseSchemaFromAST(oldContent), ParseSchemaFromAST(newContent))...)
+	report.Changes = append(report.Changes, CompareImportSupport(ParseImportSupportFromAST(oldContent), ParseImportSupportFromAST(newContent))...)
 
 	// Check retry error codes changes - parse from full file content
 	oldRetryCodes := ParseRetryErrorCodesFromContent(oldContent)
 	newRetryCodes := ParseRetryErrorCodesFromContent(newContent)
-	retryBreaking := IsRetryCodeBreaking(oldRetryCodes, newRetryCodes)
+	report.Changes = append(report.Changes, CompareRetryCodes(oldRetryCodes, newRetryCodes)...)
 
-	if !schemaBreaking && !retryBreaking {
+	logChanges(report.Changes)
+	report.Breaking = hasBreakingChange(report.Changes)
+	reports = append(reports, report)
+
+	if !report.Breaking {
 		log.Infof("--- PASS")
 		return true
 	} else {
@@ -136,55 +167,103 @@ func checkResourceBreakingChange(filePath, oldRevision, newRevision string) bool
 	}
 }
 
+const (
+	SeverityBreaking = "breaking"
+	SeverityWarning  = "warning"
+)
+
+// BreakingChange is a change of a resource between two revisions, which breaks the existing configurations or states
+// if its severity is breaking.
+type BreakingChange struct {
+	Attribute string `json:"attribute,omitempty"`
+	Kind      string `json:"kind"`
+	Severity  string `json:"severity"`
+	Old       string `json:"old,omitempty"`
+	New       string `json:"new,omitempty"`
+	Message   string `json:"message"`
+}
+
+// ResourceReport is the machine-readable result of checking a resource.
+type ResourceReport struct {
+	Resource string           `json:"resource"`
+	File     string           `json:"file"`
+	Breaking bool             `json:"breaking"`
+	Changes  []BreakingChange `json:"changes"`
+}
+
+func newResourceReport(filePath string) ResourceReport {
+	return ResourceReport{
+		Resource: strings.TrimSuffix(strings.TrimPrefix(filePath[strings.LastIndex(filePath, "/")+1:], "resource_"), ".go"),
+		File:     filePath,
+		Changes:  []BreakingChange{},
+	}
+}
+
 func IsBreakingChange(oldAttrs, newAttrs map[string]map[string]interface{}) (res bool) {
-	// First check for removed attributes
-	for filedName, oldAttr := range oldAttrs {
+	changes := CompareSchemas(oldAttrs, newAttrs)
+	logChanges(changes)
+	return hasBreakingChange(changes)
+}
+
+// CompareSchemas compares the attributes parsed by ParseSchemaFromAST, including the nested attributes whose names
+// are the paths like "rule.port", and returns the changes sorted by the attribute names.
+func CompareSchemas(oldAttrs, newAttrs map[string]map[string]interface{}) []BreakingChange {
+	var changes []BreakingChange
+	breaking := func(fieldName, kind string, oldValue, newValue interface{}, format string, args ...interface{}) {
+		changes = append(changes, newBreakingChange(fieldName, kind, SeverityBreaking, oldValue, newValue, format, args...))
+	}
+	warning := func(fieldName, kind string, oldValue, newValue interface{}, format string, args ...interface{}) {
+		changes = append(changes, newBreakingChange(fieldName, kind, SeverityWarning, oldValue, newValue, format, args...))
+	}
+
+	for _, fieldName := range sortedKeys(oldAttrs) {
+		oldAttr := oldAttrs[fieldName]
 		// Check if attribute was deleted
-		newAttr, attributeExists := newAttrs[filedName]
+		newAttr, attributeExists := newAttrs[fieldName]
 		if !attributeExists {
-			res = true
-			log.Errorf("[Breaking Change]: Attribute '%v' should not been removed!", filedName)
+			// the nested attributes are reported by their removed parent
+			if parent, ok := parentAttribute(fieldName); ok {
+				if _, parentExists := newAttrs[parent]; !parentExists {
+					continue
+				}
+			}
+			breaking(fieldName, "AttributeRemoved", nil, nil, "Attribute '%v' should not been removed!", fieldName)
 			continue
 		}
 
 		// Optional -> Required
-		oldOptional, oldHasOptional := oldAttr["Optional"]
-		_, oldHasRequired := oldAttr["Required"]
-		newRequired, newHasRequired := newAttr["Required"]
-
-		// Check if field changed from optional to required
-		isOldOptional := (oldHasOptional && oldOptional.(bool)) || (!oldHasOptional && !oldHasRequired)
-		isNewRequired := newHasRequired && newRequired.(bool)
-
-		if isOldOptional && isNewRequired {
-			res = true
-			log.Errorf("[Breaking Change]: '%v' should not been changed from optional to required!", filedName)
-		}
-
-		// Check if field changed from non-required to required (when neither was explicitly optional)
-		if !oldHasRequired && newHasRequired && newRequired.(bool) {
-			// Only if it wasn't explicitly optional before
-			if !oldHasOptional || !oldOptional.(bool) {
-				res = true
-				log.Errorf("[Breaking Change]: '%v' should not been changed to required!", filedName)
-			}
+		if !boolProperty(oldAttr, "Required") && boolProperty(newAttr, "Required") {
+			breaking(fieldName, "OptionalToRequired", "optional", "required", "'%v' should not been changed from optional to required!", fieldName)
 		}
 
 		// Type changed
 		typPrev, exist1 := oldAttr["Type"]
 		typCurr, exist2 := newAttr["Type"]
-
 		if exist1 && exist2 && typPrev != typCurr {
-			res = true
-			log.Errorf("[Breaking Change]: '%v' type should not been changed from %v to %v!", filedName, typPrev, typCurr)
+			breaking(fieldName, "TypeChanged", typPrev, typCurr, "'%v' type should not been changed from %v to %v!", fieldName, typPrev, typCurr)
+		}
+
+		// The type of the elements of a list, set or map changed
+		elemPrev, exist1 := oldAttr["ElemType"]
+		elemCurr, exist2 := newAttr["ElemType"]
+		if exist1 && exist2 && elemPrev != elemCurr {
+			breaking(fieldName, "ElemTypeChanged", elemPrev, elemCurr, "'%v' element type should not been changed from %v to %v!", fieldName, elemPrev, elemCurr)
 		}
 
 		// Non-ForceNew -> ForceNew
-		_, exist1 = oldAttr["ForceNew"]
-		_, exist2 = newAttr["ForceNew"]
-		if !exist1 && exist2 {
-			res = true
-			log.Errorf("[Breaking Change]: '%v' should not been changed to ForceNew!", filedName)
+		if !boolProperty(oldAttr, "ForceNew") && boolProperty(newAttr, "ForceNew") {
+			breaking(fieldName, "ForceNewAdded", false, true, "'%v' should not been changed to ForceNew!", fieldName)
+		}
+
+		// Default value changed or removed
+		defaultPrev, exist1 := oldAttr["Default"]
+		defaultCurr, exist2 := newAttr["Default"]
+		if exist1 && !exist2 {
+			breaking(fieldName, "DefaultRemoved", defaultPrev, nil, "'%v' default value %v should not been removed!", fieldName, defaultPrev)
+		} else if exist1 && exist2 && defaultPrev != defaultCurr {
+			breaking(fieldName, "DefaultChanged", defaultPrev, defaultCurr, "'%v' default value should not been changed from %v to %v!", fieldName, defaultPrev, defaultCurr)
+		} else if !exist1 && exist2 && !boolProperty(oldAttr, "Computed") {
+			warning(fieldName, "DefaultAdded", nil, defaultCurr, "'%v' default value %v is added, and the existing resources without it will have a diff.", fieldName, defaultCurr)
 		}
 
 		// Type string/int: valid values
@@ -192,32 +271,131 @@ func IsBreakingChange(oldAttrs, newAttrs map[string]map[string]interface{}) (res
 		validateValuesNew, exist2 := newAttr["ValidateFuncValues"]
 		if exist1 {
 			if !exist2 {
-				log.Warningf("[Warning]: '%v' ValidateFunc should not been removed!", filedName)
+				warning(fieldName, "ValidateFuncRemoved", nil, nil, "'%v' ValidateFunc should not been removed!", fieldName)
 			} else {
-				for key, _ := range validateValuesOld.(map[string]struct{}) {
+				for _, key := range sortedKeys(validateValuesOld.(map[string]struct{})) {
 					if _, ok := validateValuesNew.(map[string]struct{})[key]; !ok {
-						res = true
-						log.Errorf("[Breaking Change]: '%v' valid value %s should not been removed!", filedName, key)
+						breaking(fieldName, "ValidValueRemoved", key, nil, "'%v' valid value %s should not been removed!", fieldName, key)
 					}
 				}
 			}
+		} else if exist2 {
+			warning(fieldName, "ValidValuesAdded", nil, strings.Join(sortedKeys(validateValuesNew.(map[string]struct{})), ","), "'%v' valid values are limited, and the other values in the existing configurations will be refused.", fieldName)
+		}
+
+		// Type int: valid range
+		rangeOld, exist1 := oldAttr["ValidateFuncRange"]
+		rangeNew, exist2 := newAttr["ValidateFuncRange"]
+		if exist1 && exist2 {
+			oldRange, newRange := rangeOld.([2]string), rangeNew.([2]string)
+			if isNarrowed(oldRange[0], newRange[0], true) || isNarrowed(oldRange[1], newRange[1], false) {
+				breaking(fieldName, "ValidRangeNarrowed", formatRange(oldRange), formatRange(newRange), "'%v' valid range should not been narrowed from %s to %s!", fieldName, formatRange(oldRange), formatRange(newRange))
+			}
 		}
 	}
 
 	// Check for newly added required attributes (Breaking Change)
-	for fieldName, newAttr := range newAttrs {
-		_, existedBefore := oldAttrs[fieldName]
-		if !existedBefore {
-			// This is a new field
-			newRequired, hasRequired := newAttr["Required"]
-			if hasRequired && newRequired.(bool) {
-				res = true
-				log.Errorf("[Breaking Change]: New required attribute '%v' should not been added! New fields must be Optional.", fieldName)
+	for _, fieldName := range sortedKeys(newAttrs) {
+		if _, existedBefore := oldAttrs[fieldName]; existedBefore || !boolProperty(newAttrs[fieldName], "Required") {
+			continue
+		}
+		// a new block can have the required attributes, because it is not in the existing configurations
+		if parent, ok := parentAttribute(fieldName); ok {
+			if _, parentExisted := oldAttrs[parent]; !parentExisted {
+				continue
 			}
 		}
+		breaking(fieldName, "RequiredAdded", nil, "required", "New required attribute '%v' should not been added! New fields must be Optional.", fieldName)
 	}
 
-	return
+	return changes
+}
+
+// CompareImportSupport reports the removed import support, which breaks the existing terraform import commands and
+// import blocks.
+func CompareImportSupport(oldImportable, newImportable bool) []BreakingChange {
+	if oldImportable && !newImportable {
+		return []BreakingChange{newBreakingChange("", "ImportRemoved", SeverityBreaking, true, false, "The import support should not been removed!")}
+	}
+	return nil
+}
+
+func newBreakingChange(fieldName, kind, severity string, oldValue, newValue interface{}, format string, args ...interface{}) BreakingChange {
+	change := BreakingChange{
+		Attribute: fieldName,
+		Kind:      kind,
+		Severity:  severity,
+		Message:   fmt.Sprintf(format, args...),
+	}
+	if oldValue != nil {
+		change.Old = fmt.Sprint(oldValue)
+	}
+	if newValue != nil {
+		change.New = fmt.Sprint(newValue)
+	}
+	return change
+}
+
+func logChanges(changes []BreakingChange) {
+	for _, change := range changes {
+		if change.Severity == SeverityBreaking {
+			log.Errorf("[Breaking Change]: %s", change.Message)
+		} else {
+			log.Warningf("[Warning]: %s", change.Message)
+		}
+	}
+}
+
+func hasBreakingChange(changes []BreakingChange) bool {
+	for _, change := range changes {
+		if change.Severity == SeverityBreaking {
+			return true
+		}
+	}
+	return false
+}
+
+func boolProperty(attr map[string]interface{}, name string) bool {
+	v, ok := attr[name].(bool)
+	return ok && v
+}
+
+// parentAttribute returns the parent block of a nested attribute, like rule of rule.port.
+func parentAttribute(fieldName string) (string, bool) {
+	if index := strings.LastIndex(fieldName, "."); index > 0 {
+		return fieldName[:index], true
+	}
+	return "", false
+}
+
+// isNarrowed reports whether the new bound of a range is tighter than the old one. The bounds which are not numbers,
+// like constants, are compared as they are.
+func isNarrowed(oldBound, newBound string, lower bool) bool {
+	if oldBound == newBound {
+		return false
+	}
+	oldNumber, err1 := strconv.ParseFloat(oldBound, 64)
+	newNumber, err2 := strconv.ParseFloat(newBound, 64)
+	if err1 != nil || err2 != nil {
+		return true
+	}
+	if lower {
+		return newNumber > oldNumber
+	}
+	return newNumber < oldNumber
+}
+
+func formatRange(r [2]string) string {
+	return fmt.Sprintf("[%s, %s]", r[0], r[1])
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 // getFileContentFromGit retrieves file content from git at specified revision
@@ -257,7 +435,8 @@ func checkNewResourceRequiredFields(content string) bool {
 	return true
 }
 
-// ParseSchemaFromAST uses Go AST to parse schema definition from source code
+// ParseSchemaFromAST uses Go AST to parse schema definition from source code. The attributes of the nested blocks
+// defined by Elem: &schema.Resource{} are named by their paths, like "rule.port".
 func ParseSchemaFromAST(source string) map[string]map[string]interface{} {
 	attributeMap := make(map[string]map[string]interface{})
 
@@ -274,7 +453,9 @@ func ParseSchemaFromAST(source string) map[string]map[string]interface{} {
 		if compLit, ok := n.(*ast.CompositeLit); ok {
 			// Check if this is a Schema map
 			if isSchemaMap(compLit) {
-				extractSchemaFields(compLit, attributeMap)
+				extractSchemaFields(compLit, "", attributeMap)
+				// the nested schema maps have been extracted with the paths of their parents
+				return false
 			}
 		}
 		return true
@@ -283,31 +464,68 @@ func ParseSchemaFromAST(source string) map[string]map[string]interface{} {
 	return attributeMap
 }
 
+// ParseImportSupportFromAST checks whether the resource defined in the source code supports terraform import.
+func ParseImportSupportFromAST(source string) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "", source, 0)
+	if err != nil {
+		log.Warningf("Failed to parse file: %v", err)
+		return false
+	}
+	importable := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		compLit, ok := n.(*ast.CompositeLit)
+		if !ok || !isSchemaType(compLit.Type, "Resource") {
+			return !importable
+		}
+		for _, elt := range compLit.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if ident, ok := kv.Key.(*ast.Ident); ok && ident.Name == "Importer" && !isNilExpr(kv.Value) {
+					importable = true
+				}
+			}
+		}
+		return !importable
+	})
+	return importable
+}
+
 // isSchemaMap checks if a composite literal is a schema.Schema map
 func isSchemaMap(compLit *ast.CompositeLit) bool {
 	if mapType, ok := compLit.Type.(*ast.MapType); ok {
 		if starExpr, ok := mapType.Value.(*ast.StarExpr); ok {
-			if selExpr, ok := starExpr.X.(*ast.SelectorExpr); ok {
-				if ident, ok := selExpr.X.(*ast.Ident); ok {
-					return ident.Name == "schema" && selExpr.Sel.Name == "Schema"
-				}
-			}
+			return isSchemaType(starExpr.X, "Schema")
+		}
+	}
+	return false
+}
+
+// isSchemaType checks if an expression is the type schema.<name>
+func isSchemaType(expr ast.Expr, name string) bool {
+	if selExpr, ok := expr.(*ast.SelectorExpr); ok {
+		if ident, ok := selExpr.X.(*ast.Ident); ok {
+			return ident.Name == "schema" && selExpr.Sel.Name == name
 		}
 	}
 	return false
 }
 
-// extractSchemaFields extracts field information from schema map
-func extractSchemaFields(compLit *ast.CompositeLit, attributeMap map[string]map[string]interface{}) {
+func isNilExpr(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+// extractSchemaFields extracts field information from schema map, and the names of the fields are prefixed by the
+// path of their parent block.
+func extractSchemaFields(compLit *ast.CompositeLit, prefix string, attributeMap map[string]map[string]interface{}) {
 	for _, elt := range compLit.Elts {
 		if kv, ok := elt.(*ast.KeyValueExpr); ok {
 			// Get field name
 			var fieldName string
 			if basicLit, ok := kv.Key.(*ast.BasicLit); ok {
-				fieldName = strings.Trim(basicLit.Value, `"`)
+				fieldName = prefix + strings.Trim(basicLit.Value, `"`)
 			}
 
-			if fieldName == "" {
+			if fieldName == prefix {
 				continue
 			}
 
@@ -316,12 +534,10 @@ func extractSchemaFields(compLit *ast.CompositeLit, attributeMap map[string]map[
 			fieldProps["Name"] = fieldName
 
 			// Parse field definition (should be another composite literal)
-			if fieldComp, ok := kv.Value.(*ast.CompositeLit); ok {
+			if fieldComp := compositeLit(kv.Value); fieldComp != nil {
 				extractFieldProperties(fieldComp, fieldProps)
-			} else if unary, ok := kv.Value.(*ast.UnaryExpr); ok {
-				// Handle &schema.Schema{...}
-				if fieldComp, ok := unary.X.(*ast.CompositeLit); ok {
-					extractFieldProperties(fieldComp, fieldProps)
+				if nested := nestedSchemaMap(fieldComp); nested != nil {
+					extractSchemaFields(nested, fieldName+".", attributeMap)
 				}
 			}
 
@@ -330,7 +546,43 @@ func extractSchemaFields(compLit *ast.CompositeLit, attributeMap map[string]map[
 	}
 }
 
-// extractFieldProperties extracts Type, Optional, Required, ForceNew etc from field definition
+// compositeLit returns the composite literal of {...} and &{...}
+func compositeLit(expr ast.Expr) *ast.CompositeLit {
+	if unary, ok := expr.(*ast.UnaryExpr); ok {
+		expr = unary.X
+	}
+	compLit, _ := expr.(*ast.CompositeLit)
+	return compLit
+}
+
+// nestedSchemaMap returns the schema map of the nested block defined by Elem: &schema.Resource{Schema: ...}
+func nestedSchemaMap(field *ast.CompositeLit) *ast.CompositeLit {
+	for _, elt := range field.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if ident, ok := kv.Key.(*ast.Ident); !ok || ident.Name != "Elem" {
+			continue
+		}
+		elem := compositeLit(kv.Value)
+		if elem == nil || !isSchemaType(elem.Type, "Resource") {
+			return nil
+		}
+		for _, elemElt := range elem.Elts {
+			if elemKv, ok := elemElt.(*ast.KeyValueExpr); ok {
+				if ident, ok := elemKv.Key.(*ast.Ident); ok && ident.Name == "Schema" {
+					if schemaMap := compositeLit(elemKv.Value); schemaMap != nil && isSchemaMap(schemaMap) {
+						return schemaMap
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// extractFieldProperties extracts Type, Optional, Required, ForceNew, Default, ValidateFunc etc from field definition
 func extractFieldProperties(compLit *ast.CompositeLit, props map[string]interface{}) {
 	for _, elt := range compLit.Elts {
 		if kv, ok := elt.(*ast.KeyValueExpr); ok {
@@ -352,14 +604,67 @@ func extractFieldProperties(compLit *ast.CompositeLit, props map[string]interfac
 				if ident, ok := kv.Value.(*ast.Ident); ok {
 					props[propName] = (ident.Name == "true")
 				}
+			case "Default":
+				props[propName] = types.ExprString(kv.Value)
 			case "ValidateFunc":
-				// Extract validation function (simplified for now)
 				props[propName] = "present"
+				extractValidateFunc(kv.Value, props)
+			case "Elem":
+				// Elem: &schema.Schema{Type: schema.TypeString, ValidateFunc: ...} of a list, set or map
+				if elem := compositeLit(kv.Value); elem != nil && isSchemaType(elem.Type, "Schema") {
+					elemProps := make(map[string]interface{})
+					extractFieldProperties(elem, elemProps)
+					if v, ok := elemProps["Type"]; ok {
+						props["ElemType"] = v
+					}
+					for _, key := range []string{"ValidateFuncValues", "ValidateFuncRange"} {
+						if v, ok := elemProps[key]; ok {
+							props[key] = v
+						}
+					}
+				}
 			}
 		}
 	}
 }
 
+// extractValidateFunc extracts the valid values of StringInSlice and IntInSlice, and the valid range of IntBetween,
+// including the ones wrapped by validation.All and validation.Any.
+func extractValidateFunc(expr ast.Expr, props map[string]interface{}) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		var funcName string
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			funcName = fun.Name
+		case *ast.SelectorExpr:
+			funcName = fun.Sel.Name
+		}
+		switch funcName {
+		case "StringInSlice", "IntInSlice":
+			values := make(map[string]struct{})
+			if len(call.Args) > 0 {
+				if slice := compositeLit(call.Args[0]); slice != nil {
+					for _, value := range slice.Elts {
+						values[strings.Trim(types.ExprString(value), `"`)] = struct{}{}
+					}
+				}
+			}
+			props["ValidateFuncValues"] = values
+			return false
+		case "IntBetween":
+			if len(call.Args) == 2 {
+				props["ValidateFuncRange"] = [2]string{types.ExprString(call.Args[0]), types.ExprString(call.Args[1])}
+			}
+			return false
+		}
+		return true
+	})
+}
+
 // ParseRetryErrorCodesFromContent extracts retry error codes from full file content
 // Format: IsExpectedErrors(err, []string{"ErrorCode1", "ErrorCode2"})
 // Map structure: map[apiName]map[errorCode]struct{}
@@ -410,29 +715,24 @@ func ParseRetryErrorCodesFromContent(content string) map[string]map[string]struc
 
 // IsRetryCodeBreaking checks if retry error codes have been reduced for each API
 func IsRetryCodeBreaking(oldRetryCodes, newRetryCodes map[string]map[string]struct{}) bool {
-	res := false
+	changes := CompareRetryCodes(oldRetryCodes, newRetryCodes)
+	logChanges(changes)
+	return hasBreakingChange(changes)
+}
 
-	// Check each API's retry codes
-	for apiName, oldCodes := range oldRetryCodes {
+// CompareRetryCodes returns the retry error codes removed from each API
+func CompareRetryCodes(oldRetryCodes, newRetryCodes map[string]map[string]struct{}) []BreakingChange {
+	var changes []BreakingChange
+	for _, apiName := range sortedKeys(oldRetryCodes) {
 		newCodes, exist := newRetryCodes[apiName]
-		if !exist {
-			// The entire IsExpectedErrors call was removed for this API
-			res = true
-			log.Errorf("[Breaking Change]: Retry error codes for API '%s' have been completely removed!", apiName)
-			for oldCode := range oldCodes {
-				log.Errorf("  - Removed error code: '%s'", oldCode)
-			}
-			continue
-		}
-
-		// Check if any error code was removed
-		for oldCode := range oldCodes {
-			if _, stillExists := newCodes[oldCode]; !stillExists {
-				res = true
-				log.Errorf("[Breaking Change]: Retry error code '%s' for API '%s' should not been removed!", oldCode, apiName)
+		for _, oldCode := range sortedKeys(oldRetryCodes[apiName]) {
+			if !exist {
+				// The entire IsExpectedErrors call was removed for this API
+				changes = append(changes, newBreakingChange("", "RetryCodeRemoved", SeverityBreaking, oldCode, nil, "Retry error code '%s' for API '%s' should not been removed, and all of the retry error codes of the API have been removed!", oldCode, apiName))
+			} else if _, stillExists := newCodes[oldCode]; !stillExists {
+				changes = append(changes, newBreakingChange("", "RetryCodeRemoved", SeverityBreaking, oldCode, nil, "Retry error code '%s' for API '%s' should not been removed!", oldCode, apiName))
 			}
 		}
 	}
-
-	return res
+	return changes
 }
@@ -2,6 +2,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -743,3 +744,174 @@ if IsExpectedErrors(err, []string{"Throttling"}) {`,
 		})
 	}
 }
+
+const testOldResourceSource = `package alicloud
+
+func resourceAliCloudTestResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"payment_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PayAsYouGo",
+				ValidateFunc: StringInSlice([]string{"PayAsYouGo", "Subscription"}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.All(IntBetween(1, 60)),
+			},
+			"zone_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+`
+
+// TestNestedSchemaParsing tests if we can parse the nested blocks, defaults, element types and valid values
+func TestNestedSchemaParsing(t *testing.T) {
+	attrs := ParseSchemaFromAST(testOldResourceSource)
+	for _, name := range []string{"payment_type", "period", "zone_ids", "rule", "rule.port", "rule.protocol"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("Expected attribute %s to be parsed", name)
+		}
+	}
+	if _, ok := attrs["port"]; ok {
+		t.Error("Expected the nested attribute port to be named by its path")
+	}
+	if attrs["payment_type"]["Default"] != `"PayAsYouGo"` {
+		t.Errorf("Expected default value \"PayAsYouGo\", got %v", attrs["payment_type"]["Default"])
+	}
+	if _, ok := attrs["payment_type"]["ValidateFuncValues"].(map[string]struct{})["Subscription"]; !ok {
+		t.Errorf("Expected valid value Subscription, got %v", attrs["payment_type"]["ValidateFuncValues"])
+	}
+	if _, ok := attrs["rule.protocol"]["ValidateFuncValues"].(map[string]struct{})["udp"]; !ok {
+		t.Errorf("Expected nested valid value udp, got %v", attrs["rule.protocol"]["ValidateFuncValues"])
+	}
+	if attrs["period"]["ValidateFuncRange"] != [2]string{"1", "60"} {
+		t.Errorf("Expected valid range [1, 60], got %v", attrs["period"]["ValidateFuncRange"])
+	}
+	if attrs["zone_ids"]["ElemType"] != "TypeString" {
+		t.Errorf("Expected element type TypeString, got %v", attrs["zone_ids"]["ElemType"])
+	}
+	if !ParseImportSupportFromAST(testOldResourceSource) {
+		t.Error("Expected the import support to be parsed")
+	}
+	fmt.Println("✓ Test passed: Nested schema parsed")
+}
+
+// TestNestedSchemaBreakingChanges tests if we can detect the breaking changes of all dimensions
+func TestNestedSchemaBreakingChanges(t *testing.T) {
+	newSource := strings.NewReplacer(
+		`Default:      "PayAsYouGo"`, `Default:      "Subscription"`,
+		`[]string{"tcp", "udp"}`, `[]string{"tcp"}`,
+		`IntBetween(1, 60)`, `IntBetween(1, 36)`,
+		`Elem:     &schema.Schema{Type: schema.TypeString}`, `Elem:     &schema.Schema{Type: schema.TypeInt}`,
+		`"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},`, `"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"weight": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},`,
+		`Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},`, ``,
+	).Replace(testOldResourceSource)
+	newSource = strings.Replace(newSource, `"protocol": {`, `"protocol_name": {`, 1)
+
+	changes := CompareSchemas(ParseSchemaFromAST(testOldResourceSource), ParseSchemaFromAST(newSource))
+	changes = append(changes, CompareImportSupport(ParseImportSupportFromAST(testOldResourceSource), ParseImportSupportFromAST(newSource))...)
+	kinds := make(map[string]string)
+	for _, change := range changes {
+		if change.Severity == SeverityBreaking {
+			kinds[change.Kind+":"+change.Attribute] = change.Message
+		}
+	}
+	for _, expected := range []string{
+		"DefaultChanged:payment_type",
+		"ValidRangeNarrowed:period",
+		"ElemTypeChanged:zone_ids",
+		"ForceNewAdded:rule.port",
+		"RequiredAdded:rule.weight",
+		"AttributeRemoved:rule.protocol",
+		"ImportRemoved:",
+	} {
+		if _, ok := kinds[expected]; !ok {
+			t.Errorf("Expected breaking change %s, got %v", expected, kinds)
+		}
+	}
+	if len(kinds) != 7 {
+		t.Errorf("Expected 7 breaking changes, got %v", kinds)
+	}
+	fmt.Println("✓ Test passed: Nested schema breaking changes detected")
+}
+
+// TestNewBlockWithRequiredAttribute tests that a new block can have required attributes
+func TestNewBlockWithRequiredAttribute(t *testing.T) {
+	oldAttrs := map[string]map[string]interface{}{
+		"field1": {"Name": "field1", "Type": "TypeString", "Optional": true},
+	}
+	newAttrs := map[string]map[string]interface{}{
+		"field1":       {"Name": "field1", "Type": "TypeString", "Optional": true},
+		"block":        {"Name": "block", "Type": "TypeList", "Optional": true},
+		"block.field2": {"Name": "block.field2", "Type": "TypeString", "Required": true},
+	}
+	if IsBreakingChange(oldAttrs, newAttrs) {
+		t.Error("Expected no breaking change for a new block with a required attribute")
+	}
+
+	// the removed block is reported once without its nested attributes
+	changes := CompareSchemas(newAttrs, oldAttrs)
+	if len(changes) != 1 || changes[0].Kind != "AttributeRemoved" || changes[0].Attribute != "block" {
+		t.Errorf("Expected only the removed block to be reported, got %v", changes)
+	}
+	fmt.Println("✓ Test passed: New block with required attribute allowed")
+}
+
+// TestResourceReport tests the machine-readable report of a resource
+func TestResourceReport(t *testing.T) {
+	report := newResourceReport("alicloud/resource_alicloud_vpc.go")
+	report.Changes = append(report.Changes, CompareRetryCodes(
+		map[string]map[string]struct{}{"DeleteVpc": {"IncorrectStatus": {}}},
+		map[string]map[string]struct{}{"DeleteVpc": {}},
+	)...)
+	report.Breaking = hasBreakingChange(report.Changes)
+	content, err := json.Marshal(report)
+	if err != nil {
+		t.Fatalf("Failed to marshal the report: %v", err)
+	}
+	expected := `{"resource":"alicloud_vpc","file":"alicloud/resource_alicloud_vpc.go","breaking":true,"changes":[{"kind":"RetryCodeRemoved","severity":"breaking","old":"IncorrectStatus","message":"Retry error code 'IncorrectStatus' for API 'DeleteVpc' should not been removed!"}]}`
+	if string(content) != expected {
+		t.Errorf("Expected report %s, got %s", expected, content)
+	}
+	fmt.Println("✓ Test passed: Resource report generated")
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+var (
+	region      = flag.String("region", "", "the region of the endpoints, defaults to the env ALIBABA_CLOUD_REGION or ALICLOUD_REGION")
+	products    = flag.String("products", "", "the comma separated product codes to prewarm, defaults to all of the products resolved by the Location service")
+	accountType = flag.String("account-type", "", "the account type of the endpoints, Domestic or International, which is detected by the credential if it is not set")
+	prewarm     = flag.Bool("prewarm", false, "describe the endpoints by the Location service and save them to the endpoint cache")
+	export      = flag.String("export", "", "the path to export the cached endpoints in the endpoints.xml format, which can be loaded by TF_ENDPOINT_PATH")
+)
+
+// The endpoint cache is in the directory of the env ALIBABA_CLOUD_ENDPOINT_CACHE_DIR or TF_PLUGIN_CACHE_DIR. Usage:
+//
+//	go run scripts/endpoint/endpoint_cache.go -region cn-hangzhou -prewarm -export endpoints.xml
+func main() {
+	flag.Parse()
+	regionId := strings.TrimSpace(*region)
+	if regionId == "" {
+		regionId = getEnv("ALIBABA_CLOUD_REGION", "ALICLOUD_REGION")
+	}
+	if regionId == "" {
+		log.Println("the region is required")
+		os.Exit(1)
+	}
+	if !*prewarm && *export == "" {
+		log.Println("at least one of -prewarm and -export is required")
+		os.Exit(1)
+	}
+
+	if *prewarm {
+		config, err := newConfig(regionId)
+		if err != nil {
+			log.Println("initializing the config failed, error: ", err)
+			os.Exit(1)
+		}
+		client, err := config.Client()
+		if err != nil {
+			log.Println("initializing the client failed, error: ", err)
+			os.Exit(1)
+		}
+		*accountType = config.AccountType
+		var productCodes []string
+		for _, productCode := range strings.Split(*products, ",") {
+			if productCode = strings.TrimSpace(productCode); productCode != "" {
+				productCodes = append(productCodes, productCode)
+			}
+		}
+		if err := client.PrewarmEndpointCache(productCodes); err != nil {
+			log.Println("prewarming the endpoint cache failed, error: ", err)
+			os.Exit(1)
+		}
+		log.Printf("prewarming the endpoint cache of the region %s and account type %s succeeded", regionId, *accountType)
+	}
+
+	if *export != "" {
+		if *accountType == "" {
+			*accountType = "Domestic"
+		}
+		if err := connectivity.ExportEndpointCache(*export, regionId, *accountType); err != nil {
+			log.Println("exporting the endpoint cache failed, error: ", err)
+			os.Exit(1)
+		}
+		log.Printf("exporting the endpoint cache of the region %s and account type %s to %s succeeded", regionId, *accountType, *export)
+	}
+}
+
+func newConfig(regionId string) (*connectivity.Config, error) {
+	config := &connectivity.Config{
+		AccessKey:            getEnv("ALIBABA_CLOUD_ACCESS_KEY_ID", "ALICLOUD_ACCESS_KEY"),
+		SecretKey:            getEnv("ALIBABA_CLOUD_ACCESS_KEY_SECRET", "ALICLOUD_SECRET_KEY"),
+		SecurityToken:        getEnv("ALIBABA_CLOUD_SECURITY_TOKEN", "ALICLOUD_SECURITY_TOKEN"),
+		Region:               connectivity.Region(regionId),
+		RegionId:             regionId,
+		AccountType:          strings.TrimSpace(*accountType),
+		Protocol:             "HTTPS",
+		ClientReadTimeout:    60000,
+		ClientConnectTimeout: 60000,
+		SkipRegionValidation: true,
+		Endpoints:            &sync.Map{},
+		SignVersion:          &sync.Map{},
+	}
+	credentialConfig := new(credentials.Config).SetType("access_key").
+		SetAccessKeyId(config.AccessKey).
+		SetAccessKeySecret(config.SecretKey)
+	if config.SecurityToken != "" {
+		credentialConfig.SetType("sts").SetSecurityToken(config.SecurityToken)
+	}
+	credential, err := credentials.NewCredential(credentialConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.Credential = credential
+	return config, nil
+}
+
+func getEnv(keys ...string) string {
+	for _, key := range keys {
+		if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
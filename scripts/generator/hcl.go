@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var invalidLabelCharRegexp = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// resourceLabel converts the name or the id of a resource to a valid resource label, like tf_test_vpc.
+func resourceLabel(name string) string {
+	label := strings.Trim(invalidLabelCharRegexp.ReplaceAllString(name, "_"), "_")
+	if label == "" {
+		return "resource"
+	}
+	if label[0] >= '0' && label[0] <= '9' || label[0] == '-' {
+		label = "r_" + label
+	}
+	return strings.ToLower(label)
+}
+
+// writeResourceBlock writes the resource block with the configurable attributes and the import block of the resource.
+func writeResourceBlock(buf *bytes.Buffer, resourceType, label, id string, schemas map[string]*schema.Schema, values map[string]interface{}) {
+	fmt.Fprintf(buf, "resource %q %q {\n", resourceType, label)
+	writeAttributes(buf, 1, schemas, values)
+	buf.WriteString("}\n\n")
+	fmt.Fprintf(buf, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, label, hclString(id))
+}
+
+// writeAttributes writes the attributes which can be configured and are set, sorted by their names. The computed-only,
+// deprecated and sensitive attributes are skipped, and the nested resources are written as blocks.
+func writeAttributes(buf *bytes.Buffer, depth int, schemas map[string]*schema.Schema, values map[string]interface{}) {
+	indent := strings.Repeat("  ", depth)
+	keys := make([]string, 0, len(schemas))
+	for key := range schemas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := schemas[key]
+		if !isConfigurable(s) {
+			continue
+		}
+		value, ok := values[key]
+		if !ok || (!s.Required && isDefaultValue(s, value)) {
+			continue
+		}
+		if nested, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			for _, item := range toList(value) {
+				object, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(buf, "%s%s {\n", indent, key)
+				writeAttributes(buf, depth+1, nested.Schema, object)
+				fmt.Fprintf(buf, "%s}\n", indent)
+			}
+			continue
+		}
+		fmt.Fprintf(buf, "%s%s = %s\n", indent, key, hclValue(value))
+	}
+}
+
+func isConfigurable(s *schema.Schema) bool {
+	return (s.Required || s.Optional) && s.Deprecated == "" && s.Removed == "" && !s.Sensitive
+}
+
+// isDefaultValue reports whether the value of an optional attribute needn't be written, which is the zero value or
+// the default value of the attribute.
+func isDefaultValue(s *schema.Schema, value interface{}) bool {
+	if s.Default != nil {
+		return fmt.Sprint(s.Default) == fmt.Sprint(value)
+	}
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
+
+func toList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+// hclValue renders a primitive, a list, a set or a map value as an HCL expression.
+func hclValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return hclString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case *schema.Set:
+		return hclValue(v.List())
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, hclValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(v))
+		for _, key := range keys {
+			items = append(items, fmt.Sprintf("%s = %s", hclString(key), hclValue(v[key])))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	}
+	return hclString(fmt.Sprint(value))
+}
+
+// hclString quotes the string with the HCL escape sequences, and escapes the template sequences ${ and %{ so they are
+// kept as is.
+func hclString(value string) string {
+	var buf strings.Builder
+	buf.WriteByte('"')
+	for i, r := range value {
+		switch {
+		case r == '"' || r == '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r == '\n':
+			buf.WriteString(`\n`)
+		case r == '\r':
+			buf.WriteString(`\r`)
+		case r == '\t':
+			buf.WriteString(`\t`)
+		case (r == '$' || r == '%') && strings.HasPrefix(value[i+1:], "{"):
+			buf.WriteRune(r)
+			buf.WriteRune(r)
+		case !unicode.IsPrint(r) && r > 0xffff:
+			fmt.Fprintf(&buf, `\U%08x`, r)
+		case !unicode.IsPrint(r):
+			fmt.Fprintf(&buf, `\u%04x`, r)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
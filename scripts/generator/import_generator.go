@@ -0,0 +1,344 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var (
+	region      = flag.String("region", "", "the region of the resources, defaults to the env ALIBABA_CLOUD_REGION or ALICLOUD_REGION")
+	resources   = flag.String("resources", "", "the comma separated resource types to generate, defaults to all of the supported resource types")
+	output      = flag.String("output", ".", "the directory to write the generated <resource type>.tf files")
+	accountType = flag.String("account-type", "", "the account type, Domestic or International, which is detected by the credential if it is not set")
+)
+
+const (
+	paginationPageNumber = "PageNumber"
+	paginationNextToken  = "NextToken"
+	paginationMarker     = "Marker"
+)
+
+// resourceLister lists the resources of a resource type by the RPC API which backs the data source of the type.
+type resourceLister struct {
+	product    string
+	version    string
+	action     string
+	request    map[string]interface{}
+	listPath   string
+	idField    string
+	nameField  string
+	pagination string
+}
+
+// resourceListers are the resource types supported by the generator.
+var resourceListers = map[string]resourceLister{
+	"alicloud_vpc": {
+		product: "Vpc", version: "2016-04-28", action: "DescribeVpcs", pagination: paginationPageNumber,
+		listPath: "$.Vpcs.Vpc[*]", idField: "VpcId", nameField: "VpcName",
+	},
+	"alicloud_vswitch": {
+		product: "Vpc", version: "2016-04-28", action: "DescribeVSwitches", pagination: paginationPageNumber,
+		listPath: "$.VSwitches.VSwitch[*]", idField: "VSwitchId", nameField: "VSwitchName",
+	},
+	"alicloud_nat_gateway": {
+		product: "Vpc", version: "2016-04-28", action: "DescribeNatGateways", pagination: paginationPageNumber,
+		listPath: "$.NatGateways.NatGateway[*]", idField: "NatGatewayId", nameField: "Name",
+	},
+	"alicloud_eip_address": {
+		product: "Vpc", version: "2016-04-28", action: "DescribeEipAddresses", pagination: paginationPageNumber,
+		listPath: "$.EipAddresses.EipAddress[*]", idField: "AllocationId", nameField: "Name",
+	},
+	"alicloud_security_group": {
+		product: "Ecs", version: "2014-05-26", action: "DescribeSecurityGroups", pagination: paginationPageNumber,
+		listPath: "$.SecurityGroups.SecurityGroup[*]", idField: "SecurityGroupId", nameField: "SecurityGroupName",
+	},
+	"alicloud_instance": {
+		product: "Ecs", version: "2014-05-26", action: "DescribeInstances", pagination: paginationPageNumber,
+		listPath: "$.Instances.Instance[*]", idField: "InstanceId", nameField: "InstanceName",
+	},
+	"alicloud_slb_load_balancer": {
+		product: "Slb", version: "2014-05-15", action: "DescribeLoadBalancers", pagination: paginationPageNumber,
+		listPath: "$.LoadBalancers.LoadBalancer[*]", idField: "LoadBalancerId", nameField: "LoadBalancerName",
+	},
+	"alicloud_alb_load_balancer": {
+		product: "Alb", version: "2020-06-16", action: "ListLoadBalancers", pagination: paginationNextToken,
+		listPath: "$.LoadBalancers[*]", idField: "LoadBalancerId", nameField: "LoadBalancerName",
+	},
+	"alicloud_nlb_load_balancer": {
+		product: "Nlb", version: "2022-04-30", action: "ListLoadBalancers", pagination: paginationNextToken,
+		listPath: "$.LoadBalancers[*]", idField: "LoadBalancerId", nameField: "LoadBalancerName",
+	},
+	"alicloud_ram_user": {
+		product: "Ram", version: "2015-05-01", action: "ListUsers", pagination: paginationMarker,
+		listPath: "$.Users.User[*]", idField: "UserId", nameField: "UserName",
+	},
+	"alicloud_ram_group": {
+		product: "Ram", version: "2015-05-01", action: "ListGroups", pagination: paginationMarker,
+		listPath: "$.Groups.Group[*]", idField: "GroupName", nameField: "GroupName",
+	},
+	"alicloud_ram_role": {
+		product: "Ram", version: "2015-05-01", action: "ListRoles", pagination: paginationMarker,
+		listPath: "$.Roles.Role[*]", idField: "RoleName", nameField: "RoleName",
+	},
+	"alicloud_ram_policy": {
+		product: "Ram", version: "2015-05-01", action: "ListPolicies", pagination: paginationMarker,
+		request:  map[string]interface{}{"PolicyType": "Custom"},
+		listPath: "$.Policies.Policy[*]", idField: "PolicyName", nameField: "PolicyName",
+	},
+}
+
+// listedResource is a resource found by the lister.
+type listedResource struct {
+	id   string
+	name string
+}
+
+// list returns all of the resources in the region by turning the pages of the API.
+func (lister resourceLister) list(client *connectivity.AliyunClient) ([]listedResource, error) {
+	request := map[string]interface{}{
+		"RegionId": client.RegionId,
+	}
+	for key, value := range lister.request {
+		request[key] = value
+	}
+	switch lister.pagination {
+	case paginationPageNumber:
+		request["PageSize"] = 50
+		request["PageNumber"] = 1
+	case paginationNextToken:
+		request["MaxResults"] = 100
+	case paginationMarker:
+		request["MaxItems"] = 100
+	}
+	var result []listedResource
+	for {
+		response, err := client.RpcPost(lister.product, lister.version, lister.action, nil, request, true)
+		if err != nil {
+			return nil, fmt.Errorf("invoking %s got an error: %v", lister.action, err)
+		}
+		v, err := jsonpath.Get(lister.listPath, response)
+		if err != nil {
+			return nil, fmt.Errorf("getting %s from the %s response got an error: %v", lister.listPath, lister.action, err)
+		}
+		items, _ := v.([]interface{})
+		for _, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			result = append(result, listedResource{
+				id:   fmt.Sprint(object[lister.idField]),
+				name: fmt.Sprint(object[lister.nameField]),
+			})
+		}
+		switch lister.pagination {
+		case paginationPageNumber:
+			if len(items) < request["PageSize"].(int) {
+				return result, nil
+			}
+			request["PageNumber"] = request["PageNumber"].(int) + 1
+		case paginationNextToken:
+			nextToken := fmt.Sprint(response["NextToken"])
+			if response["NextToken"] == nil || nextToken == "" {
+				return result, nil
+			}
+			request["NextToken"] = nextToken
+		case paginationMarker:
+			if truncated, ok := response["IsTruncated"].(bool); !ok || !truncated {
+				return result, nil
+			}
+			request["Marker"] = response["Marker"]
+		default:
+			return result, nil
+		}
+	}
+}
+
+// generator writes the configuration and the import blocks of the existing resources. The resources are imported and
+// read by the importers and the Read functions of the provider, so the generated configuration has the same attributes
+// as the state after terraform import.
+type generator struct {
+	client    *connectivity.AliyunClient
+	resources map[string]*schema.Resource
+}
+
+func newGenerator(client *connectivity.AliyunClient) *generator {
+	return &generator{
+		client:    client,
+		resources: alicloud.Provider().(*schema.Provider).ResourcesMap,
+	}
+}
+
+// generate returns the configuration of the resources of the resource type in the region and the number of them.
+func (g *generator) generate(resourceType string) ([]byte, int, error) {
+	lister, ok := resourceListers[resourceType]
+	if !ok {
+		return nil, 0, fmt.Errorf("the resource type %s is not supported", resourceType)
+	}
+	resource, ok := g.resources[resourceType]
+	if !ok {
+		return nil, 0, fmt.Errorf("the resource type %s does not exist in the provider", resourceType)
+	}
+	listed, err := lister.list(g.client)
+	if err != nil {
+		return nil, 0, err
+	}
+	var buf bytes.Buffer
+	labels := make(map[string]int)
+	count := 0
+	for _, item := range listed {
+		d := resource.Data(nil)
+		d.SetId(item.id)
+		imported := []*schema.ResourceData{d}
+		if resource.Importer != nil && resource.Importer.State != nil {
+			if imported, err = resource.Importer.State(d, g.client); err != nil {
+				log.Printf("[WARN] importing %s %s got an error: %v. It is skipped.", resourceType, item.id, err)
+				continue
+			}
+		}
+		for _, data := range imported {
+			if err := resource.Read(data, g.client); err != nil {
+				log.Printf("[WARN] reading %s %s got an error: %v. It is skipped.", resourceType, data.Id(), err)
+				continue
+			}
+			if data.Id() == "" {
+				log.Printf("[WARN] %s %s is not found. It is skipped.", resourceType, item.id)
+				continue
+			}
+			name := item.name
+			if name == "" || name == "<nil>" {
+				name = data.Id()
+			}
+			label := resourceLabel(name)
+			if labels[label]++; labels[label] > 1 {
+				label = fmt.Sprintf("%s_%d", label, labels[label])
+			}
+			values := make(map[string]interface{}, len(resource.Schema))
+			for key := range resource.Schema {
+				values[key] = data.Get(key)
+			}
+			writeResourceBlock(&buf, resourceType, label, data.Id(), resource.Schema, values)
+			count++
+		}
+	}
+	return buf.Bytes(), count, nil
+}
+
+// The generator writes the configuration and the import blocks of the existing resources in a region, so they can be
+// brought under the management of Terraform by terraform plan and apply. Usage:
+//
+//	go run ./scripts/generator -region cn-hangzhou -resources alicloud_vpc,alicloud_vswitch -output imported
+func main() {
+	flag.Parse()
+	regionId := strings.TrimSpace(*region)
+	if regionId == "" {
+		regionId = getEnv("ALIBABA_CLOUD_REGION", "ALICLOUD_REGION")
+	}
+	if regionId == "" {
+		log.Println("the region is required")
+		os.Exit(1)
+	}
+	var resourceTypes []string
+	for _, resourceType := range strings.Split(*resources, ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	if len(resourceTypes) == 0 {
+		for resourceType := range resourceListers {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+		sort.Strings(resourceTypes)
+	}
+
+	config, err := newConfig(regionId)
+	if err != nil {
+		log.Println("initializing the config failed, error: ", err)
+		os.Exit(1)
+	}
+	client, err := config.Client()
+	if err != nil {
+		log.Println("initializing the client failed, error: ", err)
+		os.Exit(1)
+	}
+	if err := os.MkdirAll(*output, 0755); err != nil {
+		log.Println("creating the output directory failed, error: ", err)
+		os.Exit(1)
+	}
+	g := newGenerator(client)
+	failed := false
+	for _, resourceType := range resourceTypes {
+		content, count, err := g.generate(resourceType)
+		if err != nil {
+			log.Printf("generating %s failed, error: %v", resourceType, err)
+			failed = true
+			continue
+		}
+		if count == 0 {
+			log.Printf("there is no %s in the region %s", resourceType, regionId)
+			continue
+		}
+		path := filepath.Join(*output, resourceType+".tf")
+		if err := ioutil.WriteFile(path, content, 0644); err != nil {
+			log.Printf("writing %s failed, error: %v", path, err)
+			failed = true
+			continue
+		}
+		log.Printf("generating %d %s to %s succeeded", count, resourceType, path)
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func newConfig(regionId string) (*connectivity.Config, error) {
+	config := &connectivity.Config{
+		AccessKey:            getEnv("ALIBABA_CLOUD_ACCESS_KEY_ID", "ALICLOUD_ACCESS_KEY"),
+		SecretKey:            getEnv("ALIBABA_CLOUD_ACCESS_KEY_SECRET", "ALICLOUD_SECRET_KEY"),
+		SecurityToken:        getEnv("ALIBABA_CLOUD_SECURITY_TOKEN", "ALICLOUD_SECURITY_TOKEN"),
+		Region:               connectivity.Region(regionId),
+		RegionId:             regionId,
+		AccountType:          strings.TrimSpace(*accountType),
+		Protocol:             "HTTPS",
+		ClientReadTimeout:    60000,
+		ClientConnectTimeout: 60000,
+		SkipRegionValidation: true,
+		Endpoints:            &sync.Map{},
+		SignVersion:          &sync.Map{},
+	}
+	credentialConfig := new(credentials.Config).SetType("access_key").
+		SetAccessKeyId(config.AccessKey).
+		SetAccessKeySecret(config.SecretKey)
+	if config.SecurityToken != "" {
+		credentialConfig.SetType("sts").SetSecurityToken(config.SecurityToken)
+	}
+	credential, err := credentials.NewCredential(credentialConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.Credential = credential
+	return config, nil
+}
+
+func getEnv(keys ...string) string {
+	for _, key := range keys {
+		if v := strings.TrimSpace(os.Getenv(key)); v != "" {
+			return v
+		}
+	}
+	return ""
+}
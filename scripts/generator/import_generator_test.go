@@ -0,0 +1,138 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+// testGeneratorClient returns the client whose requests are replayed from the cassette of the test.
+func testGeneratorClient(t *testing.T, endpoints map[string]string) *connectivity.AliyunClient {
+	t.Setenv(connectivity.RecorderModeEnv, connectivity.RecorderModeReplay)
+	t.Setenv(connectivity.RecorderCassetteDirEnv, "testdata/cassettes")
+	t.Setenv("ALICLOUD_ACCESS_KEY", "test-ak")
+	t.Setenv("ALICLOUD_SECRET_KEY", "test-sk")
+	recorder, err := connectivity.StartRecorder(t.Name())
+	if err != nil {
+		t.Fatalf("starting the recorder got an error: %v", err)
+	}
+	t.Cleanup(func() {
+		recorder.Stop()
+	})
+	config, err := newConfig("cn-hangzhou")
+	if err != nil {
+		t.Fatalf("initializing the config got an error: %v", err)
+	}
+	config.AccountType = "Domestic"
+	for product, endpoint := range endpoints {
+		config.Endpoints.Store(product, endpoint)
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("initializing the client got an error: %v", err)
+	}
+	return client
+}
+
+func TestGenerateVpc(t *testing.T) {
+	client := testGeneratorClient(t, map[string]string{"vpc": "vpc.cn-hangzhou.aliyuncs.com"})
+	content, count, err := newGenerator(client).generate("alicloud_vpc")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, `resource "alicloud_vpc" "tf-test_vpc" {
+  cidr_block = "172.16.0.0/12"
+  description = "managed by $${team}"
+  dns_hostname_status = "DISABLED"
+  resource_group_id = "rg-acfmtfgenerator01"
+  system_route_table_route_propagation_enable = true
+  tags = { "Env" = "test" }
+  vpc_name = "tf-test vpc"
+}
+
+import {
+  to = alicloud_vpc.tf-test_vpc
+  id = "vpc-bp1tfgenerator01"
+}
+
+`, string(content))
+
+	_, _, err = newGenerator(client).generate("alicloud_unknown")
+	assert.NotNil(t, err)
+}
+
+func TestWriteAttributes(t *testing.T) {
+	schemas := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"port": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"password": {
+			Type:      schema.TypeString,
+			Optional:  true,
+			Sensitive: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"zone_ids": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cidr": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"priority": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+	values := map[string]interface{}{
+		"name":     "tf-test\n\"quoted\"",
+		"enabled":  false,
+		"port":     0,
+		"password": "secret",
+		"status":   "Available",
+		"zone_ids": []interface{}{"cn-hangzhou-h", "cn-hangzhou-i"},
+		"rule": []interface{}{
+			map[string]interface{}{"cidr": "10.0.0.0/8", "priority": 1},
+		},
+	}
+	var buf bytes.Buffer
+	writeAttributes(&buf, 1, schemas, values)
+	assert.Equal(t, `  enabled = false
+  name = "tf-test\n\"quoted\""
+  rule {
+    cidr = "10.0.0.0/8"
+    priority = 1
+  }
+  zone_ids = ["cn-hangzhou-h", "cn-hangzhou-i"]
+`, buf.String())
+
+	assert.Equal(t, "tf-test_vpc", resourceLabel("tf-test vpc"))
+	assert.Equal(t, "r_123", resourceLabel("123"))
+	assert.Equal(t, "resource", resourceLabel("***"))
+	assert.Equal(t, `"100%%{ and $${var}"`, hclString("100%{ and ${var}"))
+}